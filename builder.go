@@ -0,0 +1,350 @@
+package runware
+
+import (
+	"fmt"
+)
+
+// ImageInferenceOption changes a request being built, e.g. to share presets
+// between builders
+type ImageInferenceOption func(req *NewImageInferenceReq)
+
+// ControlNetOption sets the optional parameters of a ControlNet
+type ControlNetOption func(cn *ControlNet)
+
+// ImageInferenceBuilder builds a NewImageInferenceReq step by step. Every
+// setter checks its own arguments; Build reports all the problems found as a
+// *ValidationError and otherwise runs the validation of ImageInference.
+//
+//	req, err := runware.NewTextToImage("runware:100@1", "a lighthouse at dusk").
+//		Size(1024, 1024).
+//		Steps(30).
+//		WithLora("civitai:123@1", 0.8).
+//		Build()
+type ImageInferenceBuilder struct {
+	req NewImageInferenceReq
+	v   validation
+}
+
+// NewTextToImage starts a text to image request
+func NewTextToImage(model ModelID, prompt string) *ImageInferenceBuilder {
+	b := &ImageInferenceBuilder{req: NewImageInferenceReq{
+		TaskType:       ImageInference,
+		Model:          model,
+		PositivePrompt: prompt,
+	}}
+	
+	if model == "" {
+		b.v.required("model", "")
+	}
+	model.check(&b.v, "model")
+	if prompt == "" {
+		b.v.required("positivePrompt", "")
+	}
+	
+	return b
+}
+
+// NewImageToImage starts a request transforming seedImage, keeping more of it
+// as strength gets lower
+func NewImageToImage(model ModelID, prompt, seedImage string, strength float64) *ImageInferenceBuilder {
+	return NewTextToImage(model, prompt).seedImage(seedImage).Strength(strength)
+}
+
+// NewInpainting starts a request repainting the white area of maskImage
+func NewInpainting(model ModelID, prompt, seedImage, maskImage string) *ImageInferenceBuilder {
+	b := NewTextToImage(model, prompt).seedImage(seedImage)
+	
+	b.v.image("maskImage", maskImage)
+	b.req.MaskImage = maskImage
+	
+	return b
+}
+
+// NewOutpainting starts a request extending seedImage by extents, which must
+// be multiples of 64
+func NewOutpainting(model ModelID, prompt, seedImage string, extents Outpaint) *ImageInferenceBuilder {
+	b := NewTextToImage(model, prompt).seedImage(seedImage)
+	
+	checkOutpaint(&b.v, extents)
+	b.req.Outpaint = &extents
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) seedImage(seedImage string) *ImageInferenceBuilder {
+	b.v.image("seedImage", seedImage)
+	b.req.SeedImage = seedImage
+	
+	return b
+}
+
+// Architecture selects the validation profile instead of detecting it from
+// the model. Set it before the options it affects.
+func (b *ImageInferenceBuilder) Architecture(architecture string) *ImageInferenceBuilder {
+	b.req.Architecture = architecture
+	return b
+}
+
+// Size sets the output dimensions within the limits of the model architecture
+func (b *ImageInferenceBuilder) Size(width, height int) *ImageInferenceBuilder {
+	imageInferenceProfile(b.req).checkSize(&b.v, width, height)
+	b.req.Width, b.req.Height = width, height
+	
+	return b
+}
+
+// AspectRatio sets the output dimensions closest to ratio and megapixels
+// that the model architecture accepts
+func (b *ImageInferenceBuilder) AspectRatio(ratio AspectRatio, megapixels float64) *ImageInferenceBuilder {
+	width, height, err := ratio.Size(megapixels, imageInferenceProfile(b.req).Architecture)
+	if err != nil {
+		b.v.incorrect("aspectRatio", "positive W:H and megapixels", ratio.String())
+		return b
+	}
+	
+	return b.Size(width, height)
+}
+
+func (b *ImageInferenceBuilder) NegativePrompt(prompt string) *ImageInferenceBuilder {
+	b.req.NegativePrompt = prompt
+	return b
+}
+
+func (b *ImageInferenceBuilder) Steps(steps int) *ImageInferenceBuilder {
+	imageInferenceProfile(b.req).checkSteps(&b.v, steps)
+	b.req.Steps = steps
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) CFGScale(scale float64) *ImageInferenceBuilder {
+	imageInferenceProfile(b.req).checkCFGScale(&b.v, scale)
+	b.req.CFGScale = scale
+	b.explicit("CFGScale")
+	
+	return b
+}
+
+// Seed makes the generation reproducible
+func (b *ImageInferenceBuilder) Seed(seed int64) *ImageInferenceBuilder {
+	b.req.Seed = &seed
+	return b
+}
+
+func (b *ImageInferenceBuilder) ClipSkip(clipSkip int) *ImageInferenceBuilder {
+	if clipSkip < 0 || clipSkip > 2 {
+		b.v.incorrect("clipSkip", "0-2", clipSkip)
+	}
+	b.req.ClipSkip = &clipSkip
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) Scheduler(scheduler string) *ImageInferenceBuilder {
+	imageInferenceProfile(b.req).checkScheduler(&b.v, scheduler)
+	b.req.Scheduler = scheduler
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) VAE(model ModelID) *ImageInferenceBuilder {
+	model.check(&b.v, "vae")
+	b.req.VAE = model
+	
+	return b
+}
+
+// Strength sets how much a seed image is changed, 0-1
+func (b *ImageInferenceBuilder) Strength(strength float64) *ImageInferenceBuilder {
+	if strength < 0 || strength > 1 {
+		b.v.incorrect("strength", "0-1", strength)
+	}
+	b.req.Strength = strength
+	b.explicit("strength")
+	
+	return b
+}
+
+// MaskMargin adds context around the masked area when inpainting, 32-128
+func (b *ImageInferenceBuilder) MaskMargin(margin int) *ImageInferenceBuilder {
+	if b.req.MaskImage == "" {
+		b.v.required("maskImage", "when maskMargin is provided")
+	}
+	if margin < 32 || margin > 128 {
+		b.v.incorrect("maskMargin", "32-128", margin)
+	}
+	b.req.MaskMargin = margin
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) NumberResults(n int) *ImageInferenceBuilder {
+	if n < 1 || n > 20 {
+		b.v.incorrect("numberResults", "1-20", n)
+	}
+	b.req.NumberResults = n
+	
+	return b
+}
+
+// Output sets how results are delivered, empty values keep the defaults
+func (b *ImageInferenceBuilder) Output(outputType, outputFormat string, outputQuality int) *ImageInferenceBuilder {
+	checkOutputOptions(&b.v, outputType, outputFormat, outputQuality)
+	b.req.OutputType = outputType
+	b.req.OutputFormat = outputFormat
+	b.req.OutputQuality = outputQuality
+	
+	return b
+}
+
+func (b *ImageInferenceBuilder) CheckNSFW() *ImageInferenceBuilder {
+	b.req.CheckNSFW = true
+	return b
+}
+
+func (b *ImageInferenceBuilder) IncludeCost() *ImageInferenceBuilder {
+	b.req.IncludeCost = true
+	return b
+}
+
+// WithLora adds a LoRA, weight -4-4
+func (b *ImageInferenceBuilder) WithLora(model ModelID, weight float64) *ImageInferenceBuilder {
+	lora := Lora{Model: model, Weight: weight}
+	checkLora(&b.v, len(b.req.Lora), lora)
+	b.req.Lora = append(b.req.Lora, lora)
+	
+	return b
+}
+
+// WithControlNet adds a ControlNet guided by guideImage, weight 0-1
+func (b *ImageInferenceBuilder) WithControlNet(model ModelID, guideImage string, weight float64, opts ...ControlNetOption) *ImageInferenceBuilder {
+	cn := ControlNet{Model: model, GuideImage: guideImage, Weight: weight}
+	for _, opt := range opts {
+		opt(&cn)
+	}
+	
+	i := len(b.req.ControlNet)
+	checkControlNet(&b.v, i, cn)
+	if guideImage != "" {
+		b.v.image(fmt.Sprintf("controlNet[%d].guideImage", i), guideImage)
+	}
+	b.req.ControlNet = append(b.req.ControlNet, cn)
+	
+	return b
+}
+
+// ControlNetSteps limits a ControlNet to the steps between start and end
+func ControlNetSteps(start, end int) ControlNetOption {
+	return func(cn *ControlNet) {
+		cn.StartStep = &start
+		cn.EndStep = &end
+	}
+}
+
+// ControlNetMode sets the control mode, e.g. "prompt", "controlnet" or "balanced"
+func ControlNetMode(mode string) ControlNetOption {
+	return func(cn *ControlNet) {
+		cn.ControlMode = mode
+	}
+}
+
+// WithEmbedding adds a textual inversion embedding
+func (b *ImageInferenceBuilder) WithEmbedding(model ModelID, weight float64) *ImageInferenceBuilder {
+	path := fmt.Sprintf("embeddings[%d].model", len(b.req.Embeddings))
+	if model == "" {
+		b.v.required(path, "")
+	}
+	model.check(&b.v, path)
+	b.req.Embeddings = append(b.req.Embeddings, Embedding{Model: model, Weight: weight})
+	
+	return b
+}
+
+// WithIPAdapter adds an IP-Adapter guided by guideImage, weight 0-1
+func (b *ImageInferenceBuilder) WithIPAdapter(model ModelID, guideImage string, weight float64) *ImageInferenceBuilder {
+	ipa := IPAdapter{Model: model, GuideImage: guideImage, Weight: weight}
+	
+	i := len(b.req.IPAdapters)
+	checkIPAdapter(&b.v, i, ipa)
+	if guideImage != "" {
+		b.v.image(fmt.Sprintf("ipAdapters[%d].guideImage", i), guideImage)
+	}
+	b.req.IPAdapters = append(b.req.IPAdapters, ipa)
+	
+	return b
+}
+
+// WithRefiner hands the generation to a refiner model from startStep on
+func (b *ImageInferenceBuilder) WithRefiner(model ModelID, startStep int) *ImageInferenceBuilder {
+	if model == "" {
+		b.v.required("refiner.model", "")
+	}
+	model.check(&b.v, "refiner.model")
+	if startStep < 1 {
+		b.v.incorrect("refiner.startStep", ">=1", startStep)
+	}
+	b.req.Refiner = &Refiner{Model: model, StartStep: &startStep}
+	
+	return b
+}
+
+// WithPuLID keeps the identity of the person shown in inputImage, idWeight 0-3
+func (b *ImageInferenceBuilder) WithPuLID(inputImage string, idWeight int) *ImageInferenceBuilder {
+	pulid := PuLID{InputImages: []string{inputImage}, IdWeight: idWeight}
+	
+	b.v.image("puLID.inputImages[0]", inputImage)
+	checkPuLID(&b.v, pulid)
+	b.req.PuLID = &pulid
+	
+	return b
+}
+
+// WithACEPlusPlus keeps a character consistent using the reference in
+// inputImage. aceType is one of the ACEType constants; local editing also
+// needs a mask.
+func (b *ImageInferenceBuilder) WithACEPlusPlus(aceType, inputImage, inputMask string, repaintingScale float64) *ImageInferenceBuilder {
+	ace := ACEPlusPlus{Type: aceType, InputImages: []string{inputImage}, RepaintingScale: repaintingScale}
+	if inputMask != "" {
+		ace.InputMasks = []string{inputMask}
+		b.v.image("acePlusPlus.inputMasks[0]", inputMask)
+	}
+	
+	b.v.image("acePlusPlus.inputImages[0]", inputImage)
+	checkACEPlusPlus(&b.v, ace)
+	b.req.ACEPlusPlus = &ace
+	
+	return b
+}
+
+// Apply runs opts on the request being built
+func (b *ImageInferenceBuilder) Apply(opts ...ImageInferenceOption) *ImageInferenceBuilder {
+	for _, opt := range opts {
+		opt(&b.req)
+	}
+	return b
+}
+
+// Build returns the request with defaults applied, or a *ValidationError
+// listing the problems found while building it
+func (b *ImageInferenceBuilder) Build() (NewImageInferenceReq, error) {
+	if err := b.v.err(); err != nil {
+		return NewImageInferenceReq{}, err
+	}
+	
+	// Defaults and the task UUID go into a copy, so every Build of the same
+	// builder returns a new task
+	req := b.req
+	req = *mergeImageInferenceReqWithDefaults(&req)
+	if err := validateImageInferenceReq(req); err != nil {
+		return NewImageInferenceReq{}, err
+	}
+	
+	return req, nil
+}
+
+// explicit keeps the value set for a field even when it is zero
+func (b *ImageInferenceBuilder) explicit(name string) {
+	if !b.req.Explicit.has(name) {
+		b.req.Explicit = append(b.req.Explicit, name)
+	}
+}
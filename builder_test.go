@@ -0,0 +1,158 @@
+package runware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testImageUUID = "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10"
+
+func TestImageInferenceBuilder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		builder *ImageInferenceBuilder
+		check   func(t *testing.T, req NewImageInferenceReq)
+	}{
+		{
+			name: "text to image",
+			builder: NewTextToImage("runware:100@1", "a lighthouse at dusk").
+				Size(1024, 1024).
+				Steps(30).
+				Seed(42).
+				WithLora("civitai:123@1", 0.8).
+				WithControlNet("civitai:456@1", testImageUUID, 0.5, ControlNetSteps(0, 10), ControlNetMode("balanced")),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, 30, req.Steps)
+				assert.Equal(t, int64(42), *req.Seed)
+				assert.Equal(t, []Lora{{Model: "civitai:123@1", Weight: 0.8}}, req.Lora)
+				require.Len(t, req.ControlNet, 1)
+				assert.Equal(t, 10, *req.ControlNet[0].EndStep)
+				assert.Equal(t, "balanced", req.ControlNet[0].ControlMode)
+			},
+		},
+		{
+			name:    "image to image",
+			builder: NewImageToImage("runware:100@1", "as a watercolor", testImageUUID, 0.4).Size(512, 768),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, testImageUUID, req.SeedImage)
+				assert.Equal(t, 0.4, req.Strength)
+			},
+		},
+		{
+			name:    "inpainting",
+			builder: NewInpainting("runware:100@1", "a red door", testImageUUID, testImageUUID).Size(512, 512).MaskMargin(64),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, testImageUUID, req.MaskImage)
+				assert.Equal(t, 64, req.MaskMargin)
+			},
+		},
+		{
+			name:    "outpainting",
+			builder: NewOutpainting("runware:100@1", "more beach", testImageUUID, Outpaint{Left: 128, Right: 128, Blur: 8}).Size(1280, 1024),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, 128, req.Outpaint.Left)
+			},
+		},
+		{
+			name:    "PuLID",
+			builder: NewTextToImage("runware:101@1", "portrait in the rain").Size(1024, 1024).WithPuLID(testImageUUID, 1),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, []string{testImageUUID}, req.PuLID.InputImages)
+			},
+		},
+		{
+			name: "ACE++ local editing",
+			builder: NewTextToImage("runware:102@1", "same character, smiling").
+				Size(1024, 1024).
+				WithACEPlusPlus(ACETypeLocalEditing, testImageUUID, testImageUUID, 0.5),
+			check: func(t *testing.T, req NewImageInferenceReq) {
+				assert.Equal(t, ACETypeLocalEditing, req.ACEPlusPlus.Type)
+				assert.Equal(t, []string{testImageUUID}, req.ACEPlusPlus.InputMasks)
+			},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := tc.builder.Build()
+			require.NoError(t, err)
+			require.NoError(t, validateImageInferenceReq(req))
+			assert.NotEmpty(t, req.TaskUUID)
+			assert.Equal(t, ImageInference, req.TaskType)
+			tc.check(t, req)
+		})
+	}
+}
+
+func TestImageInferenceBuilderErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		builder *ImageInferenceBuilder
+		wantErr error
+		field   string
+	}{
+		{"missing prompt", NewTextToImage("runware:100@1", ""), ErrFieldRequired, "positivePrompt"},
+		{"width", NewTextToImage("runware:100@1", "p").Size(1000, 1024), ErrFieldIncorrectVal, "width"},
+		{"steps", NewTextToImage("runware:100@1", "p").Steps(0), ErrFieldIncorrectVal, "steps"},
+		{"lora weight", NewTextToImage("runware:100@1", "p").WithLora("civitai:1@1", 1).WithLora("civitai:2@1", 5), ErrFieldIncorrectVal, "lora[1].weight"},
+		{"control net steps", NewTextToImage("runware:100@1", "p").WithControlNet("civitai:1@1", testImageUUID, 1, ControlNetSteps(10, 5)), ErrFieldIncorrectVal, "controlNet[0].startStep"},
+		{"strength", NewImageToImage("runware:100@1", "p", testImageUUID, 1.5), ErrFieldIncorrectVal, "strength"},
+		{"mask margin without mask", NewTextToImage("runware:100@1", "p").MaskMargin(64), ErrFieldRequired, "maskImage"},
+		{"outpaint extents", NewOutpainting("runware:100@1", "p", testImageUUID, Outpaint{Top: 100}), ErrFieldIncorrectVal, "outpaint.top"},
+		{"ACE++ type", NewTextToImage("runware:100@1", "p").WithACEPlusPlus("unknown", testImageUUID, "", 0.5), ErrFieldIncorrectVal, "acePlusPlus.type"},
+		{"ACE++ mask", NewTextToImage("runware:100@1", "p").WithACEPlusPlus(ACETypeLocalEditing, testImageUUID, "", 0.5), ErrFieldRequired, "acePlusPlus.inputMasks"},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tc.wantErr), err.Error())
+			assert.Contains(t, err.Error(), "["+tc.field+"]")
+		})
+	}
+}
+
+func TestImageInferenceBuilderReportsAllViolations(t *testing.T) {
+	_, err := NewTextToImage("runware:100@1", "").Steps(500).CFGScale(100).WithLora("civitai:1@1", 5).Build()
+	
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	
+	paths := make([]string, len(verr.Violations))
+	for i, v := range verr.Violations {
+		paths[i] = v.Path
+	}
+	assert.Equal(t, []string{"positivePrompt", "steps", "CFGScale", "lora[0].weight"}, paths)
+	assert.Equal(t, 500, verr.Violations[1].Value)
+	assert.ErrorIs(t, err, ErrFieldRequired)
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+}
+
+func TestImageInferenceBuilderApply(t *testing.T) {
+	portrait := func(req *NewImageInferenceReq) {
+		req.Width, req.Height = 768, 1024
+	}
+	
+	req, err := NewTextToImage("runware:100@1", "p").Apply(portrait).Build()
+	require.NoError(t, err)
+	assert.Equal(t, 768, req.Width)
+	assert.Equal(t, 1024, req.Height)
+}
+
+func TestImageInferenceBuilderBuildsNewTasks(t *testing.T) {
+	b := NewTextToImage("runware:100@1", "p").Size(1024, 1024)
+	
+	first, err := b.Build()
+	require.NoError(t, err)
+	second, err := b.Build()
+	require.NoError(t, err)
+	
+	assert.NotEmpty(t, first.TaskUUID)
+	assert.NotEmpty(t, second.TaskUUID)
+	assert.NotEqual(t, first.TaskUUID, second.TaskUUID)
+	assert.Empty(t, b.req.TaskUUID)
+}
@@ -1,9 +1,41 @@
 package runware
 
+const defaultBufferSize = 64
+
+// OverflowPolicy decides what happens to an incoming message when the buffer
+// of messages waiting to be dispatched is full. Blocking, the default, loses
+// nothing but stalls the connection behind a slow consumer. The drop policies
+// keep reading, but a dropped message is gone for good: it never reaches its
+// request or the OnUnmatched hook, even when it is a result already paid for.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock stops reading from the connection until there is room,
+	// which also delays heartbeats
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest buffered message to make room
+	OverflowDropOldest
+	// OverflowDropNewest discards the incoming message
+	OverflowDropNewest
+)
+
 type RunwareConfig struct {
 	APIKey    string
 	ConnAddr  ConnAddr
 	KeepAlive bool
+
+	// LegacyProtocol sends heartbeats in the legacy event format
+	LegacyProtocol bool
+
+	// BufferSize is the number of incoming messages held until they are
+	// dispatched, 64 when zero. Overflow decides what happens when the
+	// buffer is full.
+	BufferSize int
+	Overflow   OverflowPolicy
+
+	// Debug logs every message sent and received in full. Messages can carry
+	// megabytes of base64 image data, so leave it off outside debugging.
+	Debug bool
 }
 
 type SDKConfig struct {
@@ -11,4 +43,26 @@ type SDKConfig struct {
 	ConnAddr  ConnAddr
 	KeepAlive bool
 	Client    Runware
+
+	// MaxRetries is how many times a task is resent when it could not be sent
+	// or timed out waiting for its result. Zero disables retries. Timed out
+	// tasks are only resent when that cannot run billed work twice, e.g.
+	// getResponse or modelSearch; a timed out imageInference is returned as
+	// is and its result can still be picked up with TaskStatus.
+	MaxRetries int
+
+	// LegacyProtocol connects with the newConnection event and reads image
+	// results from the newImages event instead of the current
+	// authentication task and data/errors envelope. It is meant for servers
+	// that do not support the current protocol yet.
+	LegacyProtocol bool
+
+	// BufferSize and Overflow configure the incoming message buffer of the
+	// default client, see RunwareConfig
+	BufferSize int
+	Overflow   OverflowPolicy
+
+	// Debug logs every message sent and received in full. Messages can carry
+	// megabytes of base64 image data, so leave it off outside debugging.
+	Debug bool
 }
@@ -0,0 +1,277 @@
+package runware
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+)
+
+// waiterBuffer is the number of stream items a waiter holds before the
+// dispatcher waits for its caller to catch up
+const waiterBuffer = 16
+
+// Message is an incoming result or error that no pending request was waiting
+// for, typically the result of a task that already timed out. For the current
+// protocol there is one Message per item; legacy messages are passed whole
+// with an empty Event.
+type Message struct {
+	// Event is ResponseData or ResponseErrors, or empty for legacy messages
+	Event    string
+	TaskType string
+	TaskUUID string
+	Raw      json.RawMessage
+	Err      error
+}
+
+// waiter receives the items and errors routed to a pending request
+type waiter struct {
+	items chan frameItem
+	errs  chan error
+	done  chan struct{}
+}
+
+// dispatcher is the single reader of incoming messages. It routes result
+// items to the request waiting for their taskUUID and legacy events to the
+// oldest request waiting for them; anything else goes to the unmatched hook.
+type dispatcher struct {
+	mu          sync.Mutex
+	tasks       map[string]*waiter
+	events      map[string][]*waiter
+	onUnmatched func(Message)
+}
+
+// OnUnmatched registers handler to receive the messages no pending request
+// was waiting for, such as results arriving after their request timed out.
+// Without a handler they are logged and dropped. handler runs on the
+// dispatching goroutine and should hand long work off.
+func (sdk *SDK) OnUnmatched(handler func(Message)) {
+	d := sdk.dispatch()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	d.onUnmatched = handler
+}
+
+// dispatch returns the dispatcher of the SDK, starting it on first use
+func (sdk *SDK) dispatch() *dispatcher {
+	sdk.dispatchOnce.Do(func() {
+		sdk.dispatcher = &dispatcher{
+			tasks:  make(map[string]*waiter),
+			events: make(map[string][]*waiter),
+		}
+		go sdk.dispatchLoop()
+	})
+	return sdk.dispatcher
+}
+
+func (sdk *SDK) dispatchLoop() {
+	for {
+		msg, ok := <-sdk.Client.Listen()
+		if !ok {
+			return
+		}
+		sdk.dispatcher.route(msg)
+	}
+}
+
+// waitTasks registers a waiter for the results of taskUUIDs
+func (d *dispatcher) waitTasks(taskUUIDs []string, buffer int) *waiter {
+	w := newWaiter(buffer)
+	
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, taskUUID := range taskUUIDs {
+		d.tasks[taskUUID] = w
+	}
+	
+	return w
+}
+
+// waitEvent registers a waiter for the next legacy event
+func (d *dispatcher) waitEvent(event string) *waiter {
+	w := newWaiter(1)
+	
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.events[event] = append(d.events[event], w)
+	
+	return w
+}
+
+// release unregisters w. Items routed to it afterwards are unmatched.
+func (d *dispatcher) release(w *waiter) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	for taskUUID, tw := range d.tasks {
+		if tw == w {
+			delete(d.tasks, taskUUID)
+		}
+	}
+	for event, waiters := range d.events {
+		for i, ew := range waiters {
+			if ew == w {
+				d.events[event] = append(waiters[:i:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(d.events[event]) == 0 {
+			delete(d.events, event)
+		}
+	}
+	
+	close(w.done)
+}
+
+func (d *dispatcher) route(msg []byte) {
+	f, err := decodeFrame(msg)
+	if err != nil {
+		d.unmatched(Message{Raw: msg, Err: err})
+		return
+	}
+	
+	// Errors of the legacy protocol are not tied to a request
+	if errMsg, ok := f.legacyErr(); ok {
+		if !d.broadcast(errMsg) {
+			d.unmatched(Message{Raw: msg, Err: errMsg})
+		}
+		return
+	}
+	
+	for i := range f.Errors {
+		apiErr := &f.Errors[i]
+		delivered := false
+		if apiErr.TaskUUID == "" {
+			delivered = d.broadcast(apiErr)
+		} else if w := d.task(apiErr.TaskUUID); w != nil {
+			delivered = w.fail(apiErr)
+		}
+		if !delivered {
+			d.unmatched(Message{Event: ResponseErrors, TaskType: apiErr.TaskType, TaskUUID: apiErr.TaskUUID, Err: apiErr})
+		}
+	}
+	
+	for _, item := range f.Data {
+		if w := d.task(item.TaskUUID); w == nil || !w.deliver(item) {
+			d.unmatched(Message{Event: ResponseData, TaskType: item.TaskType, TaskUUID: item.TaskUUID, Raw: item.Raw})
+		}
+	}
+	
+	if len(f.Data) == 0 && len(f.Errors) == 0 {
+		d.routeLegacy(msg)
+	}
+}
+
+// routeLegacy hands the events of a legacy message to their oldest waiter
+func (d *dispatcher) routeLegacy(msg []byte) {
+	fields, err := decodeLegacyFields(msg)
+	if err != nil {
+		d.unmatched(Message{Raw: msg, Err: err})
+		return
+	}
+	
+	delivered := false
+	for event, raw := range fields {
+		w := d.event(event)
+		if w == nil {
+			continue
+		}
+		
+		value, err := legacyValue(raw)
+		if err != nil {
+			delivered = w.fail(err) || delivered
+			continue
+		}
+		if len(value) == 0 {
+			continue
+		}
+		delivered = w.deliver(frameItem{Raw: value}) || delivered
+	}
+	
+	if !delivered {
+		d.unmatched(Message{Raw: msg})
+	}
+}
+
+func (d *dispatcher) task(taskUUID string) *waiter {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	return d.tasks[taskUUID]
+}
+
+func (d *dispatcher) event(event string) *waiter {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	if waiters := d.events[event]; len(waiters) > 0 {
+		return waiters[0]
+	}
+	return nil
+}
+
+// broadcast sends err to every pending request, reporting whether there was any
+func (d *dispatcher) broadcast(err error) bool {
+	d.mu.Lock()
+	waiters := make(map[*waiter]struct{}, len(d.tasks))
+	for _, w := range d.tasks {
+		waiters[w] = struct{}{}
+	}
+	for _, eventWaiters := range d.events {
+		for _, w := range eventWaiters {
+			waiters[w] = struct{}{}
+		}
+	}
+	d.mu.Unlock()
+	
+	for w := range waiters {
+		w.fail(err)
+	}
+	
+	return len(waiters) > 0
+}
+
+func (d *dispatcher) unmatched(msg Message) {
+	d.mu.Lock()
+	handler := d.onUnmatched
+	d.mu.Unlock()
+	
+	if handler == nil {
+		log.Println("Skipping unmatched message", msg.Event, msg.TaskType, msg.TaskUUID)
+		return
+	}
+	handler(msg)
+}
+
+func newWaiter(buffer int) *waiter {
+	return &waiter{
+		items: make(chan frameItem, buffer),
+		errs:  make(chan error, 1),
+		done:  make(chan struct{}),
+	}
+}
+
+// deliver hands item to the waiting request, failing once it is released
+func (w *waiter) deliver(item frameItem) bool {
+	select {
+	case w.items <- item:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+// fail reports err to the waiting request, keeping only the first error
+func (w *waiter) fail(err error) bool {
+	select {
+	case <-w.done:
+		return false
+	default:
+	}
+	
+	select {
+	case w.errs <- err:
+	default:
+	}
+	return true
+}
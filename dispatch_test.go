@@ -0,0 +1,168 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchRoutesConcurrentTasks(t *testing.T) {
+	listen := make(chan []byte, 8)
+	var mu sync.Mutex
+	var taskUUIDs []string
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	mClient.SendFunc = func(b []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		
+		taskUUIDs = append(taskUUIDs, string(b))
+		if len(taskUUIDs) < 2 {
+			return nil
+		}
+		
+		// Answer both tasks in reverse order once both were sent
+		for i := len(taskUUIDs) - 1; i >= 0; i-- {
+			var task []map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(taskUUIDs[i]), &task))
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageCaption","taskUUID":"%s","text":"%s"}]}`,
+				task[0]["taskUUID"], task[0]["inputImage"]))
+		}
+		return nil
+	}
+	
+	images := []string{"https://example.com/first.png", "https://example.com/second.png"}
+	texts := make([]string, len(images))
+	
+	var wg sync.WaitGroup
+	for i, image := range images {
+		wg.Add(1)
+		go func(i int, image string) {
+			defer wg.Done()
+			resp, err := sdk.ImageCaption(context.Background(), NewImageCaptionReq{InputImage: image})
+			if assert.NoError(t, err) {
+				texts[i] = resp.Text
+			}
+		}(i, image)
+	}
+	wg.Wait()
+	
+	assert.Equal(t, images, texts)
+}
+
+func TestDispatchUnmatched(t *testing.T) {
+	listen := make(chan []byte, 1)
+	sdk := &SDK{Client: &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+	}}
+	
+	unmatched := make(chan Message, 2)
+	sdk.OnUnmatched(func(msg Message) {
+		unmatched <- msg
+	})
+	
+	// A late result of a task nobody waits for anymore
+	listen <- []byte(`{"data":[{"taskType":"imageInference","taskUUID":"late-task","imageUUID":"paid-image"}]}`)
+	
+	select {
+	case msg := <-unmatched:
+		assert.Equal(t, ResponseData, msg.Event)
+		assert.Equal(t, "late-task", msg.TaskUUID)
+		assert.JSONEq(t, `{"taskType":"imageInference","taskUUID":"late-task","imageUUID":"paid-image"}`, string(msg.Raw))
+	case <-time.After(time.Second):
+		t.Fatal("unmatched message not reported")
+	}
+	
+	listen <- []byte(`{"errors":[{"code":"invalidModel","taskUUID":"late-task"}]}`)
+	
+	select {
+	case msg := <-unmatched:
+		assert.Equal(t, ResponseErrors, msg.Event)
+		assert.ErrorIs(t, msg.Err, ErrTaskFailed)
+	case <-time.After(time.Second):
+		t.Fatal("unmatched error not reported")
+	}
+}
+
+func TestDispatchLateResult(t *testing.T) {
+	listen := make(chan []byte, 1)
+	sent := make(chan string, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var task []map[string]interface{}
+			if err := json.Unmarshal(b, &task); err != nil {
+				return err
+			}
+			sent <- task[0]["taskUUID"].(string)
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	unmatched := make(chan Message, 1)
+	sdk.OnUnmatched(func(msg Message) {
+		unmatched <- msg
+	})
+	
+	// The request gives up before the server answers
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := sdk.ImageCaption(ctx, NewImageCaptionReq{InputImage: "https://example.com/photo.png"})
+	require.Error(t, err)
+	
+	taskUUID := <-sent
+	listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageCaption","taskUUID":"%s","text":"late"}]}`, taskUUID))
+	
+	select {
+	case msg := <-unmatched:
+		assert.Equal(t, taskUUID, msg.TaskUUID)
+		assert.Contains(t, string(msg.Raw), `"text":"late"`)
+	case <-time.After(time.Second):
+		t.Fatal("late result not reported")
+	}
+}
+
+func TestEnqueueOverflow(t *testing.T) {
+	testCases := []struct {
+		name     string
+		overflow OverflowPolicy
+		want     []string
+	}{
+		{"Drop oldest", OverflowDropOldest, []string{"2", "3"}},
+		{"Drop newest", OverflowDropNewest, []string{"1", "2"}},
+	}
+	
+	assert.Equal(t, OverflowBlock, RunwareConfig{}.Overflow)
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &runware{incomingMessages: make(chan []byte, 2), overflow: tc.overflow}
+			for _, msg := range []string{"1", "2", "3"} {
+				r.enqueue([]byte(msg))
+			}
+			
+			var got []string
+			for len(r.incomingMessages) > 0 {
+				got = append(got, string(<-r.incomingMessages))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Do runs a task of any type, including task types the SDK has no dedicated
+// method for yet. req is marshaled as the task payload; its taskType and
+// taskUUID are filled in when missing. The result carrying the same taskUUID
+// is decoded into Resp.
+//
+//	type removeBgResp struct {
+//		ImageURL string `json:"imageURL"`
+//	}
+//	resp, err := runware.Do[map[string]any, removeBgResp](ctx, sdk, runware.RemoveBackground, map[string]any{
+//		"inputImage": imageUUID,
+//	})
+func Do[Req, Resp any](ctx context.Context, sdk *SDK, taskType string, req Req) (*Resp, error) {
+	task, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	
+	raw, err := sdk.doTask(ctx, taskType, task)
+	if err != nil {
+		return nil, err
+	}
+	
+	resp := new(Resp)
+	if err = json.Unmarshal(raw, resp); err != nil {
+		return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+	}
+	
+	return resp, nil
+}
+
+// DoRaw runs a task given as a JSON object, which must carry its taskType. A
+// taskUUID is generated when missing. The result is returned undecoded.
+func (sdk *SDK) DoRaw(ctx context.Context, task json.RawMessage) (json.RawMessage, error) {
+	return sdk.doTask(ctx, "", task)
+}
+
+func (sdk *SDK) doTask(ctx context.Context, taskType string, task []byte) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(task, &fields); err != nil || fields == nil {
+		return nil, fmt.Errorf("%w:[%s][JSON object]", ErrFieldIncorrectVal, "task")
+	}
+	
+	payloadType, err := stringField(fields, "taskType")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case payloadType == "" && taskType == "":
+		return nil, fmt.Errorf("%w:[%s]", ErrFieldRequired, "taskType")
+	case payloadType == "":
+		payloadType = taskType
+	case taskType != "" && payloadType != taskType:
+		return nil, fmt.Errorf("%w:[%s][%s]", ErrFieldIncorrectVal, "taskType", taskType)
+	}
+	
+	taskUUID, err := stringField(fields, "taskUUID")
+	if err != nil {
+		return nil, err
+	}
+	if taskUUID == "" {
+		taskUUID = uuid.New().String()
+	}
+	
+	fields["taskType"], _ = json.Marshal(payloadType)
+	fields["taskUUID"], _ = json.Marshal(taskUUID)
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         payloadType,
+		ResponseEvent: ResponseData,
+		Data:          fields,
+	}
+	
+	var raw json.RawMessage
+	if err = sdk.sendTask(ctx, sendReq, taskUUID, &raw); err != nil {
+		return nil, err
+	}
+	
+	return raw, nil
+}
+
+// stringField reads an optional string field of a raw task
+func stringField(fields map[string]json.RawMessage, name string) (string, error) {
+	raw, ok := fields[name]
+	if !ok || string(raw) == "null" {
+		return "", nil
+	}
+	
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", fmt.Errorf("%w:[%s][string]", ErrFieldIncorrectVal, name)
+	}
+	
+	return v, nil
+}
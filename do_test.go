@@ -0,0 +1,137 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoClient answers every task with a data item built by reply
+func echoClient(listen chan []byte, reply func(task map[string]interface{}) string) *MockRunware {
+	return &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			listen <- []byte(reply(sent[0]))
+			return nil
+		},
+	}
+}
+
+func TestDo(t *testing.T) {
+	type futureReq struct {
+		TaskUUID string `json:"taskUUID,omitempty"`
+		Prompt   string `json:"prompt"`
+	}
+	type futureResp struct {
+		TaskUUID string `json:"taskUUID"`
+		Answer   string `json:"answer"`
+	}
+	
+	var sentTask map[string]interface{}
+	listen := make(chan []byte, 1)
+	sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		sentTask = task
+		return fmt.Sprintf(`{"data":[{"taskType":"futureTask","taskUUID":"%s","answer":"42"}]}`, task["taskUUID"])
+	})}
+	
+	resp, err := Do[futureReq, futureResp](context.Background(), sdk, "futureTask", futureReq{Prompt: "question"})
+	require.NoError(t, err)
+	assert.Equal(t, "42", resp.Answer)
+	assert.Equal(t, "futureTask", sentTask["taskType"])
+	assert.Equal(t, "question", sentTask["prompt"])
+	assert.NotEmpty(t, sentTask["taskUUID"])
+	assert.Equal(t, sentTask["taskUUID"], resp.TaskUUID)
+}
+
+func TestDoRaw(t *testing.T) {
+	listen := make(chan []byte, 1)
+	sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		return fmt.Sprintf(`{"data":[{"taskType":"%s","taskUUID":"%s","ok":true}]}`, task["taskType"], task["taskUUID"])
+	})}
+	
+	raw, err := sdk.DoRaw(context.Background(), json.RawMessage(`{"taskType":"futureTask","taskUUID":"fixed-uuid"}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"taskType":"futureTask","taskUUID":"fixed-uuid","ok":true}`, string(raw))
+	
+	testCases := []struct {
+		name    string
+		task    string
+		wantErr error
+	}{
+		{"Not an object", `[1,2]`, ErrFieldIncorrectVal},
+		{"Missing task type", `{"prompt":"x"}`, ErrFieldRequired},
+		{"Task type not a string", `{"taskType":1}`, ErrFieldIncorrectVal},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := sdk.DoRaw(context.Background(), json.RawMessage(tc.task))
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+	
+	_, err = Do[map[string]string, map[string]interface{}](context.Background(), sdk, "futureTask", map[string]string{"taskType": "otherTask"})
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+}
+
+func TestDoRetriesUnsentTask(t *testing.T) {
+	listen := make(chan []byte, 1)
+	attempts := 0
+	client := echoClient(listen, func(task map[string]interface{}) string {
+		return fmt.Sprintf(`{"data":[{"taskType":"futureTask","taskUUID":"%s"}]}`, task["taskUUID"])
+	})
+	send := client.SendFunc
+	client.SendFunc = func(b []byte) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("broken pipe")
+		}
+		return send(b)
+	}
+	
+	sdk := &SDK{Client: client}
+	_, err := sdk.DoRaw(context.Background(), json.RawMessage(`{"taskType":"futureTask"}`))
+	assert.ErrorIs(t, err, ErrSendFailed)
+	
+	attempts = 0
+	sdk.maxRetries = 1
+	_, err = sdk.DoRaw(context.Background(), json.RawMessage(`{"taskType":"futureTask"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestIsRetryable(t *testing.T) {
+	timeout := fmt.Errorf("%w:[%s]", ErrRequestTimeout, "task")
+	unsent := fmt.Errorf("%w:[%s]", ErrSendFailed, "broken pipe")
+	
+	assert.True(t, isRetryable(ImageInference, unsent))
+	assert.True(t, isRetryable(GetResponse, timeout))
+	assert.True(t, isRetryable(AccountManagement, timeout))
+	assert.False(t, isRetryable(ImageInference, timeout))
+	assert.False(t, isRetryable(VideoInference, timeout))
+	assert.False(t, isRetryable("futureTask", timeout))
+	assert.False(t, isRetryable(GetResponse, ErrTaskFailed))
+}
+
+func TestConnectLegacySession(t *testing.T) {
+	for _, session := range []string{`"session-uuid"`, `{"connectionSessionUUID":"session-uuid"}`} {
+		listen := make(chan []byte, 1)
+		sdk := &SDK{legacy: true, Client: echoClient(listen, func(map[string]interface{}) string {
+			return `{"newConnectionSessionUUID":` + session + `}`
+		})}
+		
+		resp, err := sdk.Connect(context.Background(), NewConnectReq{APIKey: "test-api-key"})
+		require.NoError(t, err)
+		assert.Equal(t, "session-uuid", resp.ConnectionSessionUUID)
+	}
+}
@@ -5,15 +5,19 @@ import (
 )
 
 var (
-	ErrWsDial            = errors.New("cannot connect to ws")
-	ErrApiKeyRequired    = errors.New("api key is required")
-	ErrOutgoingIsNil     = errors.New("outgoing message cannot be nil")
-	ErrFieldRequired     = errors.New("field is required")
-	ErrFieldIncorrectVal = errors.New("field has incorrect value")
-	ErrWsUnknownError    = errors.New("unknown error")
-	ErrInvalidApiKey     = errors.New("invalid api key")
-	ErrRequestTimeout    = errors.New("request timeout")
-	ErrDecodeMessage     = errors.New("cannot decode message")
+	ErrWsDial              = errors.New("cannot connect to ws")
+	ErrApiKeyRequired      = errors.New("api key is required")
+	ErrOutgoingIsNil       = errors.New("outgoing message cannot be nil")
+	ErrFieldRequired       = errors.New("field is required")
+	ErrFieldIncorrectVal   = errors.New("field has incorrect value")
+	ErrWsUnknownError      = errors.New("unknown error")
+	ErrInvalidApiKey       = errors.New("invalid api key")
+	ErrRequestTimeout      = errors.New("request timeout")
+	ErrDecodeMessage       = errors.New("cannot decode message")
+	ErrSendFailed          = errors.New("cannot send message")
+	ErrTaskFailed          = errors.New("task failed")
+	ErrLowBalance          = errors.New("account balance is too low")
+	ErrLegacyModelUnmapped = errors.New("legacy model has no known AIR identifier")
 )
 
 // Base64 Err validations
@@ -22,4 +26,5 @@ var (
 	ErrImageIsNotBase64 = errors.New("image is not base64")
 	ErrImageUnsupported = errors.New("unsupported image format")
 	ErrImageHeader      = errors.New("image header is invalid")
+	ErrInvalidSVG       = errors.New("svg is not well-formed")
 )
@@ -0,0 +1,213 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const usageDateLayout = "2006-01-02"
+
+type NewAccountDetailsReq struct {
+	TaskType  string `json:"taskType"`
+	TaskUUID  string `json:"taskUUID"`
+	Operation string `json:"operation"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewAccountDetailsReq) MarshalJSON() ([]byte, error) {
+	type alias NewAccountDetailsReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewAccountDetailsResp struct {
+	TaskType         string  `json:"taskType"`
+	TaskUUID         string  `json:"taskUUID"`
+	OrganizationUUID string  `json:"organizationUUID"`
+	OrganizationName string  `json:"organizationName,omitempty"`
+	Balance          float64 `json:"balance"`
+	Currency         string  `json:"currency,omitempty"`
+	TimedOut         bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewAccountDetailsResp) UnmarshalJSON(data []byte) error {
+	type alias NewAccountDetailsResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+type NewAccountUsageReq struct {
+	TaskType  string `json:"taskType"`
+	TaskUUID  string `json:"taskUUID"`
+	Operation string `json:"operation"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewAccountUsageReq) MarshalJSON() ([]byte, error) {
+	type alias NewAccountUsageReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+// UsageRecord aggregates the tasks of one type run on a given day
+type UsageRecord struct {
+	Date     string  `json:"date"`
+	TaskType string  `json:"taskType"`
+	Tasks    int     `json:"tasks"`
+	Cost     float64 `json:"cost"`
+}
+
+type NewAccountUsageResp struct {
+	TaskType  string        `json:"taskType"`
+	TaskUUID  string        `json:"taskUUID"`
+	Usage     []UsageRecord `json:"usage"`
+	TotalCost float64       `json:"totalCost"`
+	TimedOut  bool          `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewAccountUsageResp) UnmarshalJSON(data []byte) error {
+	type alias NewAccountUsageResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// AccountDetails returns the organization and remaining credit of the API key.
+// A zero req is enough; it only carries TaskUUID and Extra.
+func (sdk *SDK) AccountDetails(ctx context.Context, req NewAccountDetailsReq) (*NewAccountDetailsResp, error) {
+	req = *mergeNewAccountDetailsReqWithDefaults(&req)
+	if err := validateNewAccountDetailsReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         AccountManagement,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newAccountDetailsResp := &NewAccountDetailsResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newAccountDetailsResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newAccountDetailsResp.TimedOut = true
+			return newAccountDetailsResp, err
+		}
+		return nil, err
+	}
+	
+	return newAccountDetailsResp, nil
+}
+
+// EnsureBalance fails with ErrLowBalance when the remaining credit is below
+// minimum, e.g. before submitting a batch whose cost is known
+func (sdk *SDK) EnsureBalance(ctx context.Context, minimum float64) (*NewAccountDetailsResp, error) {
+	details, err := sdk.AccountDetails(ctx, NewAccountDetailsReq{})
+	if err != nil {
+		return details, err
+	}
+	
+	if details.Balance < minimum {
+		return details, fmt.Errorf("%w:[%.4f < %.4f]", ErrLowBalance, details.Balance, minimum)
+	}
+	
+	return details, nil
+}
+
+// AccountUsage returns the usage of the API key between StartDate and EndDate
+// (YYYY-MM-DD, inclusive). EndDate defaults to today and StartDate to 30 days
+// before EndDate.
+func (sdk *SDK) AccountUsage(ctx context.Context, req NewAccountUsageReq) (*NewAccountUsageResp, error) {
+	req = *mergeNewAccountUsageReqWithDefaults(&req)
+	if err := validateNewAccountUsageReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         AccountManagement,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newAccountUsageResp := &NewAccountUsageResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newAccountUsageResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newAccountUsageResp.TimedOut = true
+			return newAccountUsageResp, err
+		}
+		return nil, err
+	}
+	
+	return newAccountUsageResp, nil
+}
+
+func NewAccountDetailsReqDefaults() *NewAccountDetailsReq {
+	return &NewAccountDetailsReq{
+		TaskType:  AccountManagement,
+		Operation: AccountOperationGetDetails,
+	}
+}
+
+func mergeNewAccountDetailsReqWithDefaults(req *NewAccountDetailsReq) *NewAccountDetailsReq {
+	_ = MergeEventRequestsWithDefaults[*NewAccountDetailsReq](req, NewAccountDetailsReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewAccountDetailsReq(req NewAccountDetailsReq) error {
+	if req.Operation != AccountOperationGetDetails {
+		return fmt.Errorf("%w:[%s][%s]", ErrFieldIncorrectVal, "operation", AccountOperationGetDetails)
+	}
+	return nil
+}
+
+func NewAccountUsageReqDefaults() *NewAccountUsageReq {
+	now := time.Now().UTC()
+	return &NewAccountUsageReq{
+		TaskType:  AccountManagement,
+		Operation: AccountOperationGetUsage,
+		EndDate:   now.Format(usageDateLayout),
+	}
+}
+
+func mergeNewAccountUsageReqWithDefaults(req *NewAccountUsageReq) *NewAccountUsageReq {
+	_ = MergeEventRequestsWithDefaults[*NewAccountUsageReq](req, NewAccountUsageReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	
+	// The default period ends at EndDate, which may be set by the caller
+	if req.StartDate == "" {
+		if end, err := time.Parse(usageDateLayout, req.EndDate); err == nil {
+			req.StartDate = end.AddDate(0, 0, -30).Format(usageDateLayout)
+		}
+	}
+	return req
+}
+
+func validateNewAccountUsageReq(req NewAccountUsageReq) error {
+	end, err := time.Parse(usageDateLayout, req.EndDate)
+	if err != nil {
+		return fmt.Errorf("%w:[%s][YYYY-MM-DD]", ErrFieldIncorrectVal, "endDate")
+	}
+	
+	start, err := time.Parse(usageDateLayout, req.StartDate)
+	if err != nil {
+		return fmt.Errorf("%w:[%s][YYYY-MM-DD]", ErrFieldIncorrectVal, "startDate")
+	}
+	
+	if end.Before(start) {
+		return fmt.Errorf("%w:[%s][not before startDate]", ErrFieldIncorrectVal, "endDate")
+	}
+	
+	return nil
+}
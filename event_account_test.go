@@ -0,0 +1,77 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureBalance(t *testing.T) {
+	listen := make(chan []byte, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			assert.Equal(t, AccountOperationGetDetails, sent[0]["operation"])
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"accountManagement","taskUUID":"%s","balance":1.25}]}`, sent[0]["taskUUID"]))
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	details, err := sdk.EnsureBalance(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1.25, details.Balance)
+	
+	_, err = sdk.EnsureBalance(context.Background(), 5)
+	assert.ErrorIs(t, err, ErrLowBalance)
+}
+
+func TestValidateNewAccountUsageReq(t *testing.T) {
+	assert.NoError(t, validateNewAccountUsageReq(*mergeNewAccountUsageReqWithDefaults(&NewAccountUsageReq{})))
+	assert.ErrorIs(t, validateNewAccountUsageReq(NewAccountUsageReq{StartDate: "2024-02-01", EndDate: "2024-01-01"}), ErrFieldIncorrectVal)
+	assert.ErrorIs(t, validateNewAccountUsageReq(NewAccountUsageReq{StartDate: "01/02/2024", EndDate: "2024-03-01"}), ErrFieldIncorrectVal)
+	
+	// The default period ends at the requested EndDate
+	req := mergeNewAccountUsageReqWithDefaults(&NewAccountUsageReq{EndDate: "2024-03-31"})
+	assert.Equal(t, "2024-03-01", req.StartDate)
+	assert.NoError(t, validateNewAccountUsageReq(*req))
+}
+
+func TestAccountDetailsSendsExtraFields(t *testing.T) {
+	listen := make(chan []byte, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			assert.Equal(t, AccountOperationGetDetails, sent[0]["operation"])
+			assert.Equal(t, "acme", sent[0]["organization"])
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"accountManagement","taskUUID":"%s","organizationUUID":"o1","balance":3}]}`, sent[0]["taskUUID"]))
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	details, err := sdk.AccountDetails(context.Background(), NewAccountDetailsReq{
+		Extra: map[string]any{"organization": "acme"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "o1", details.OrganizationUUID)
+	assert.Equal(t, 3.0, details.Balance)
+}
@@ -2,33 +2,76 @@ package runware
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"time"
 	
 	"github.com/google/uuid"
-	"github.com/tidwall/gjson"
 )
 
 type NewConnectReq struct {
 	APIKey                string `json:"apiKey"`
 	ConnectionSessionUUID string `json:"connectionSessionUUID,omitempty"`
 	TaskType              string `json:"taskType"`
+	TaskUUID              string `json:"taskUUID,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewConnectReq) MarshalJSON() ([]byte, error) {
+	type alias NewConnectReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
 }
 
 type NewConnectResp struct {
 	ConnectionSessionUUID string `json:"connectionSessionUUID"`
 	TimedOut              bool   `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
+func (resp *NewConnectResp) UnmarshalJSON(data []byte) error {
+	type alias NewConnectResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// Connect authenticates the connection, resuming the session given by
+// ConnectionSessionUUID when set. It runs the authentication task, or sends the
+// newConnection event when the SDK uses the legacy protocol.
 func (sdk *SDK) Connect(ctx context.Context, req NewConnectReq) (*NewConnectResp, error) {
 	req = *mergeNewConnectReqWithDefaults(&req)
 	if err := validateNewConnectReq(req); err != nil {
 		return nil, err
 	}
 	
+	if sdk.legacy {
+		return sdk.connectLegacy(ctx, req)
+	}
+	
+	req.TaskType = Authentication
 	sendReq := Request{
 		ID:            uuid.New().String(),
+		Event:         Authentication,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newConnectResp := &NewConnectResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newConnectResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newConnectResp.TimedOut = true
+			return newConnectResp, err
+		}
+		return nil, err
+	}
+	
+	return newConnectResp, nil
+}
+
+func (sdk *SDK) connectLegacy(ctx context.Context, req NewConnectReq) (*NewConnectResp, error) {
+	sendReq := Request{
+		ID:            req.TaskUUID,
 		Event:         NewConnection,
 		ResponseEvent: NewConnectionSessionUUID,
 		Data:          req,
@@ -36,66 +79,25 @@ func (sdk *SDK) Connect(ctx context.Context, req NewConnectReq) (*NewConnectResp
 	
 	newConnectResp := &NewConnectResp{}
 	
-	responseChan := make(chan *NewConnectResp)
-	errChan := make(chan error)
-	
-	go func() {
-		defer close(responseChan)
-		defer close(errChan)
-		
-		for msg := range sdk.Client.Listen() {
-			msgStr := string(msg)
-			
-			// Check if is an error message first
-			if gjson.Get(msgStr, "error").Bool() {
-				errorId := gjson.Get(msgStr, "errorId").Float()
-				errorMessage := gjson.Get(msgStr, "errorMessage").String()
-				
-				var err error
-				switch errorId {
-				case 19:
-					err = ErrInvalidApiKey
-				default:
-					err = ErrWsUnknownError
-				}
-				
-				errChan <- fmt.Errorf("%w:[%v:%s]", err, errorId, errorMessage)
-				return
-			}
-			
-			// Check if message contains our response event
-			if gjson.Get(msgStr, sendReq.ResponseEvent).Exists() {
-				sessionUUID := gjson.Get(msgStr, sendReq.ResponseEvent).String()
-				newConnectResp.ConnectionSessionUUID = sessionUUID
-				responseChan <- newConnectResp
-				return
-			}
-			
-			// Skip if not our event
-			log.Println("Skipping message, waiting for", sendReq.ResponseEvent)
+	var session json.RawMessage
+	if err := sdk.sendEvent(ctx, sendReq, &session); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newConnectResp.TimedOut = true
+			return newConnectResp, err
 		}
-	}()
-	
-	bSendReq, err := sendReq.ToEvent()
-	if err != nil {
 		return nil, err
 	}
 	
-	if err = sdk.Client.Send(bSendReq); err != nil {
-		return nil, err
-	}
+	newConnectResp.Raw = session
 	
-	select {
-	case resp := <-responseChan:
-		return resp, nil
-	case err = <-errChan:
-		return nil, err
-	case <-time.After(timeoutSendResponse * time.Second):
-		newConnectResp.TimedOut = true
-		return newConnectResp, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
-	case <-ctx.Done():
-		return nil, ctx.Err()
+	// The session is sent either as a bare string or wrapped in an object
+	if err := json.Unmarshal(session, &newConnectResp.ConnectionSessionUUID); err != nil {
+		if err := json.Unmarshal(session, newConnectResp); err != nil {
+			return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+		}
 	}
+	
+	return newConnectResp, nil
 }
 
 func NewConnectReqDefaults() *NewConnectReq {
@@ -106,6 +108,7 @@ func NewConnectReqDefaults() *NewConnectReq {
 
 func mergeNewConnectReqWithDefaults(req *NewConnectReq) *NewConnectReq {
 	_ = MergeEventRequestsWithDefaults[*NewConnectReq](req, NewConnectReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
 	return req
 }
 
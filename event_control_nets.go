@@ -3,11 +3,8 @@ package runware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"time"
-	
-	"github.com/google/uuid"
 )
 
 type NewControlNetsReq PreProcessControlNet
@@ -19,8 +16,24 @@ type NewControlNetsResp struct {
 	NNsfwContent  *bool  `json:"nNsfwContent"` // Pointer to handle null values
 	TaskUUID      string `json:"taskUUID"`
 	TimedOut      bool   `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (req NewControlNetsReq) MarshalJSON() ([]byte, error) {
+	type alias NewControlNetsReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
 }
 
+func (resp *NewControlNetsResp) UnmarshalJSON(data []byte) error {
+	type alias NewControlNetsResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// NewControlNets sends the legacy newPreProcessControlNet event.
+//
+// Deprecated: use ControlNetPreprocess, which runs the current preprocessing
+// task, accepts any image input form and supports every processor.
 func (sdk *SDK) NewControlNets(ctx context.Context, req NewControlNetsReq) (*NewControlNetsResp, error) {
 	req = *mergeControlNetsReqWithDefaults(&req)
 	if err := validateNewControlNetsReq(req); err != nil {
@@ -28,83 +41,26 @@ func (sdk *SDK) NewControlNets(ctx context.Context, req NewControlNetsReq) (*New
 	}
 	
 	sendReq := Request{
+		ID:            req.TaskUUID,
 		Event:         NewPreProcessControlNet,
 		ResponseEvent: NewPreProcessControlNet,
 		Data:          req,
 	}
 	
 	newControlNetsResp := &NewControlNetsResp{}
-	
-	responseChan := make(chan *NewControlNetsResp)
-	errChan := make(chan error)
-	
-	go func() {
-		defer close(responseChan)
-		defer close(errChan)
-		
-		for msg := range sdk.Client.Listen() {
-			var msgData map[string]interface{}
-			if err := json.Unmarshal(msg, &msgData); err != nil {
-				errChan <- fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
-				return
-			}
-			
-			// Check if is an error message first
-			if errMsg, ok := sdk.OnError(msgData); ok {
-				errChan <- errMsg
-				return
-			}
-			
-			for k, v := range msgData {
-				if k != sendReq.ResponseEvent {
-					log.Println("Skipping event", k, "Currently handling", sendReq.ResponseEvent)
-					continue
-				}
-				
-				bValue, err := interfaceToByte(v)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				err = json.Unmarshal(bValue, &newControlNetsResp)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				responseChan <- newControlNetsResp
-				return
-			}
+	if err := sdk.sendEvent(ctx, sendReq, newControlNetsResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newControlNetsResp.TimedOut = true
+			return newControlNetsResp, err
 		}
-		
-	}()
-	
-	bSendReq, err := sendReq.ToEvent()
-	if err != nil {
 		return nil, err
 	}
 	
-	if err = sdk.Client.Send(bSendReq); err != nil {
-		return nil, err
-	}
-	
-	select {
-	case resp := <-responseChan:
-		return resp, nil
-	case err = <-errChan:
-		return nil, err
-	case <-time.After(timeoutSendResponse * time.Second):
-		newControlNetsResp.TimedOut = true
-		return newControlNetsResp, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
+	return newControlNetsResp, nil
 }
 
 func NewControlNetsReqDefaults() *NewControlNetsReq {
 	return &NewControlNetsReq{
-		TaskUUID:           uuid.New().String(),
 		TaskType:           ControlNetPreprocessImage,
 		LowThresholdCanny:  100,
 		HighThresholdCanny: 200,
@@ -113,6 +69,7 @@ func NewControlNetsReqDefaults() *NewControlNetsReq {
 
 func mergeControlNetsReqWithDefaults(req *NewControlNetsReq) *NewControlNetsReq {
 	_ = MergeEventRequestsWithDefaults[*NewControlNetsReq](req, NewControlNetsReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
 	return req
 }
 
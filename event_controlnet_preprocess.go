@@ -0,0 +1,165 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CannyOptions are the edge detection thresholds of the canny processor
+type CannyOptions struct {
+	LowThresholdCanny  int `json:"lowThresholdCanny,omitempty"`
+	HighThresholdCanny int `json:"highThresholdCanny,omitempty"`
+}
+
+// OpenposeOptions controls which body parts the openpose processor detects
+type OpenposeOptions struct {
+	IncludeHandsAndFaceOpenPose bool `json:"includeHandsAndFaceOpenPose,omitempty"`
+}
+
+type NewControlNetPreprocessReq struct {
+	TaskType         string `json:"taskType"`
+	TaskUUID         string `json:"taskUUID"`
+	InputImage       string `json:"inputImage"`
+	PreProcessorType string `json:"preProcessorType"`
+
+	// Width and Height resize the input image before preprocessing
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
+	// Processor-specific options, only valid with the matching processor. The
+	// API defines options for canny and openpose only; the other processors
+	// take none.
+	*CannyOptions
+	*OpenposeOptions
+
+	OutputType    string `json:"outputType,omitempty"`
+	OutputFormat  string `json:"outputFormat,omitempty"`
+	OutputQuality int    `json:"outputQuality,omitempty"`
+	IncludeCost   bool   `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewControlNetPreprocessReq) MarshalJSON() ([]byte, error) {
+	type alias NewControlNetPreprocessReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewControlNetPreprocessResp struct {
+	TaskType             string  `json:"taskType"`
+	TaskUUID             string  `json:"taskUUID"`
+	InputImageUUID       string  `json:"inputImageUUID"`
+	GuideImageUUID       string  `json:"guideImageUUID"`
+	GuideImageURL        string  `json:"guideImageURL,omitempty"`
+	GuideImageBase64Data string  `json:"guideImageBase64Data,omitempty"`
+	GuideImageDataURI    string  `json:"guideImageDataURI,omitempty"`
+	Cost                 float64 `json:"cost,omitempty"`
+	TimedOut             bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewControlNetPreprocessResp) UnmarshalJSON(data []byte) error {
+	type alias NewControlNetPreprocessResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// ControlNetPreprocess turns an image into a guide image for ControlNet. The
+// returned GuideImageUUID can be used as ControlNet.GuideImage directly.
+func (sdk *SDK) ControlNetPreprocess(ctx context.Context, req NewControlNetPreprocessReq) (*NewControlNetPreprocessResp, error) {
+	req = *mergeNewControlNetPreprocessReqWithDefaults(&req)
+	if err := validateNewControlNetPreprocessReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ControlNetPreprocess,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newControlNetPreprocessResp := &NewControlNetPreprocessResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newControlNetPreprocessResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newControlNetPreprocessResp.TimedOut = true
+			return newControlNetPreprocessResp, err
+		}
+		return nil, err
+	}
+	
+	return newControlNetPreprocessResp, nil
+}
+
+// ControlNet returns a ControlNet entry guided by the preprocessed image
+func (resp *NewControlNetPreprocessResp) ControlNet(model ModelID, weight float64) ControlNet {
+	return ControlNet{
+		Model:      model,
+		GuideImage: resp.GuideImageUUID,
+		Weight:     weight,
+	}
+}
+
+func NewControlNetPreprocessReqDefaults() *NewControlNetPreprocessReq {
+	return &NewControlNetPreprocessReq{
+		TaskType:      ControlNetPreprocess,
+		OutputType:    OutputTypeURL,
+		OutputFormat:  OutputFormatPNG,
+		OutputQuality: 95,
+	}
+}
+
+func mergeNewControlNetPreprocessReqWithDefaults(req *NewControlNetPreprocessReq) *NewControlNetPreprocessReq {
+	_ = MergeEventRequestsWithDefaults[*NewControlNetPreprocessReq](req, NewControlNetPreprocessReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewControlNetPreprocessReq(req NewControlNetPreprocessReq) error {
+	if err := validateInputImage(req.InputImage); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputImage")
+	}
+	
+	switch req.PreProcessorType {
+	case ProcessorCanny, ProcessorDepth, ProcessorMlsd, ProcessorNormalbae, ProcessorOpenpose, ProcessorTile,
+		ProcessorSeg, ProcessorLineart, ProcessorLineartAnime, ProcessorShuffle, ProcessorScribble, ProcessorSoftedge:
+	case "":
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "preProcessorType")
+	default:
+		return fmt.Errorf("%w:[%s]", ErrFieldIncorrectVal, "preProcessorType")
+	}
+	
+	if req.Width != 0 && (req.Width < 128 || req.Width > 2048 || req.Width%64 != 0) {
+		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "width")
+	}
+	
+	if req.Height != 0 && (req.Height < 128 || req.Height > 2048 || req.Height%64 != 0) {
+		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "height")
+	}
+	
+	if req.CannyOptions != nil {
+		if req.PreProcessorType != ProcessorCanny {
+			return fmt.Errorf("%w:[%s only applies to %s]", ErrFieldIncorrectVal, "cannyOptions", ProcessorCanny)
+		}
+		if req.LowThresholdCanny < 0 || req.LowThresholdCanny > 255 {
+			return fmt.Errorf("%w:[%s][0-255]", ErrFieldIncorrectVal, "lowThresholdCanny")
+		}
+		if req.HighThresholdCanny < 0 || req.HighThresholdCanny > 255 {
+			return fmt.Errorf("%w:[%s][0-255]", ErrFieldIncorrectVal, "highThresholdCanny")
+		}
+		if req.HighThresholdCanny != 0 && req.LowThresholdCanny > req.HighThresholdCanny {
+			return fmt.Errorf("%w:[%s][<= highThresholdCanny]", ErrFieldIncorrectVal, "lowThresholdCanny")
+		}
+	}
+	
+	if req.OpenposeOptions != nil && req.PreProcessorType != ProcessorOpenpose {
+		return fmt.Errorf("%w:[%s only applies to %s]", ErrFieldIncorrectVal, "openposeOptions", ProcessorOpenpose)
+	}
+	
+	return validateOutputOptions(req.OutputType, req.OutputFormat, req.OutputQuality)
+}
@@ -0,0 +1,91 @@
+package runware
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNewControlNetPreprocessReq(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     NewControlNetPreprocessReq
+		wantErr error
+	}{
+		{
+			name:    "MissingProcessor",
+			req:     NewControlNetPreprocessReq{InputImage: testPNGBase64},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name: "Canny",
+			req: NewControlNetPreprocessReq{
+				InputImage:       testPNGBase64,
+				PreProcessorType: ProcessorCanny,
+				CannyOptions:     &CannyOptions{LowThresholdCanny: 100, HighThresholdCanny: 200},
+			},
+		},
+		{
+			name: "CannyThresholdsInverted",
+			req: NewControlNetPreprocessReq{
+				InputImage:       testPNGBase64,
+				PreProcessorType: ProcessorCanny,
+				CannyOptions:     &CannyOptions{LowThresholdCanny: 200, HighThresholdCanny: 100},
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "OpenposeOptionsOnDepth",
+			req: NewControlNetPreprocessReq{
+				InputImage:       testPNGBase64,
+				PreProcessorType: ProcessorDepth,
+				OpenposeOptions:  &OpenposeOptions{IncludeHandsAndFaceOpenPose: true},
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "OpenposeWithResolution",
+			req: NewControlNetPreprocessReq{
+				InputImage:       "https://example.com/pose.jpg",
+				PreProcessorType: ProcessorOpenpose,
+				Width:            768,
+				Height:           1024,
+				OpenposeOptions:  &OpenposeOptions{IncludeHandsAndFaceOpenPose: true},
+			},
+		},
+		{
+			name: "WrongResolution",
+			req: NewControlNetPreprocessReq{
+				InputImage:       testPNGBase64,
+				PreProcessorType: ProcessorLineart,
+				Width:            700,
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNewControlNetPreprocessReq(tc.req)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControlNetPreprocessReqFlattensOptions(t *testing.T) {
+	b, err := json.Marshal(NewControlNetPreprocessReq{
+		PreProcessorType: ProcessorOpenpose,
+		OpenposeOptions:  &OpenposeOptions{IncludeHandsAndFaceOpenPose: true},
+	})
+	assert.NoError(t, err)
+	
+	var fields map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, true, fields["includeHandsAndFaceOpenPose"])
+	assert.NotContains(t, fields, "lowThresholdCanny")
+}
@@ -0,0 +1,112 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type NewGetResponseReq struct {
+	TaskType string `json:"taskType"`
+	TaskUUID string `json:"taskUUID"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewGetResponseReq) MarshalJSON() ([]byte, error) {
+	type alias NewGetResponseReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+// NewGetResponseResp is the state of a task looked up by taskUUID. Only the
+// fields relevant to the original task type are set.
+type NewGetResponseResp struct {
+	TaskType        string  `json:"taskType"`
+	TaskUUID        string  `json:"taskUUID"`
+	Status          string  `json:"status,omitempty"`
+	ImageUUID       string  `json:"imageUUID,omitempty"`
+	ImageURL        string  `json:"imageURL,omitempty"`
+	ImageBase64Data string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    string  `json:"imageDataURI,omitempty"`
+	VideoUUID       string  `json:"videoUUID,omitempty"`
+	VideoURL        string  `json:"videoURL,omitempty"`
+	Text            string  `json:"text,omitempty"`
+	Seed            int64   `json:"seed,omitempty"`
+	NSFWContent     bool    `json:"NSFWContent,omitempty"`
+	Cost            float64 `json:"cost,omitempty"`
+	TimedOut        bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewGetResponseResp) UnmarshalJSON(data []byte) error {
+	type alias NewGetResponseResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// TaskStatus resolves a previously submitted task by its taskUUID using the
+// getResponse task, e.g. to pick up async results after a reconnect
+func (sdk *SDK) TaskStatus(ctx context.Context, req NewGetResponseReq) (*NewGetResponseResp, error) {
+	req = *mergeNewGetResponseReqWithDefaults(&req)
+	if err := validateNewGetResponseReq(req); err != nil {
+		return nil, err
+	}
+	
+	newGetResponseResp := &NewGetResponseResp{}
+	items, err := sdk.getResponse(ctx, req)
+	if err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newGetResponseResp.TimedOut = true
+			return newGetResponseResp, err
+		}
+		return nil, err
+	}
+	
+	if err := json.Unmarshal(items[0], newGetResponseResp); err != nil {
+		return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+	}
+	
+	return newGetResponseResp, nil
+}
+
+// getResponse sends req as is to ask the server for the current state of an
+// async task and returns every item it answers with, one per result of the task
+func (sdk *SDK) getResponse(ctx context.Context, req NewGetResponseReq) ([][]byte, error) {
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         GetResponse,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	var items [][]byte
+	err := sdk.withRetry(ctx, GetResponse, func() error {
+		var err error
+		items, err = sdk.sendTaskFrame(ctx, sendReq, req.TaskUUID)
+		return err
+	})
+	
+	return items, err
+}
+
+func NewGetResponseReqDefaults() *NewGetResponseReq {
+	return &NewGetResponseReq{
+		TaskType: GetResponse,
+	}
+}
+
+func mergeNewGetResponseReqWithDefaults(req *NewGetResponseReq) *NewGetResponseReq {
+	_ = MergeEventRequestsWithDefaults[*NewGetResponseReq](req, NewGetResponseReqDefaults())
+	return req
+}
+
+func validateNewGetResponseReq(req NewGetResponseReq) error {
+	if req.TaskUUID == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "taskUUID")
+	}
+	return nil
+}
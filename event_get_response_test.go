@@ -0,0 +1,40 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStatusSendsExtraFields(t *testing.T) {
+	listen := make(chan []byte, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			assert.Equal(t, GetResponse, sent[0]["taskType"])
+			assert.Equal(t, "beta", sent[0]["channel"])
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageInference","taskUUID":"%s","status":"success","imageUUID":"i1"}]}`, sent[0]["taskUUID"]))
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	resp, err := sdk.TaskStatus(context.Background(), NewGetResponseReq{
+		TaskUUID: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		Extra:    map[string]any{"channel": "beta"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TaskStatusSuccess, resp.Status)
+	assert.Equal(t, "i1", resp.ImageUUID)
+}
@@ -0,0 +1,134 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type NewImageCaptionReq struct {
+	TaskType    string `json:"taskType"`
+	TaskUUID    string `json:"taskUUID"`
+	InputImage  string `json:"inputImage"`
+	IncludeCost bool   `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewImageCaptionReq) MarshalJSON() ([]byte, error) {
+	type alias NewImageCaptionReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewImageCaptionResp struct {
+	TaskType string  `json:"taskType"`
+	TaskUUID string  `json:"taskUUID"`
+	Text     string  `json:"text"`
+	Cost     float64 `json:"cost,omitempty"`
+	TimedOut bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewImageCaptionResp) UnmarshalJSON(data []byte) error {
+	type alias NewImageCaptionResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// ImageCaption describes an image as text using the imageCaption task
+func (sdk *SDK) ImageCaption(ctx context.Context, req NewImageCaptionReq) (*NewImageCaptionResp, error) {
+	req = *mergeNewImageCaptionReqWithDefaults(&req)
+	if err := validateNewImageCaptionReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ImageCaption,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newImageCaptionResp := &NewImageCaptionResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newImageCaptionResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newImageCaptionResp.TimedOut = true
+			return newImageCaptionResp, err
+		}
+		return nil, err
+	}
+	
+	return newImageCaptionResp, nil
+}
+
+// ImageCaptionBatch captions several images with a single message. Responses
+// are returned in the order of reqs; on timeout the captions that have not
+// arrived are returned with TimedOut set.
+func (sdk *SDK) ImageCaptionBatch(ctx context.Context, reqs []NewImageCaptionReq) ([]*NewImageCaptionResp, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("%w:[%s]", ErrFieldRequired, "reqs")
+	}
+	
+	// Defaults are merged into a copy, the caller's slice is left untouched
+	merged := make([]NewImageCaptionReq, len(reqs))
+	taskUUIDs := make([]string, len(reqs))
+	expected := make(map[string]int, len(reqs))
+	for i, req := range reqs {
+		merged[i] = *mergeNewImageCaptionReqWithDefaults(&req)
+		if err := validateNewImageCaptionReq(merged[i]); err != nil {
+			return nil, fmt.Errorf("%w:[reqs[%d]]", err, i)
+		}
+		taskUUIDs[i] = merged[i].TaskUUID
+		expected[merged[i].TaskUUID] = 1
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ImageCaption,
+		ResponseEvent: ResponseData,
+		Data:          merged,
+	}
+	
+	items, err := sdk.sendTasks(ctx, sendReq, expected)
+	if err != nil && !errors.Is(err, ErrRequestTimeout) {
+		return nil, err
+	}
+	
+	resps := make([]*NewImageCaptionResp, len(reqs))
+	for i, taskUUID := range taskUUIDs {
+		resps[i] = &NewImageCaptionResp{TaskUUID: taskUUID}
+		item, ok := items[taskUUID]
+		if !ok {
+			resps[i].TimedOut = true
+			continue
+		}
+		if uErr := json.Unmarshal(item[0], resps[i]); uErr != nil {
+			return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, uErr.Error())
+		}
+	}
+	
+	return resps, err
+}
+
+func NewImageCaptionReqDefaults() *NewImageCaptionReq {
+	return &NewImageCaptionReq{
+		TaskType: ImageCaption,
+	}
+}
+
+func mergeNewImageCaptionReqWithDefaults(req *NewImageCaptionReq) *NewImageCaptionReq {
+	_ = MergeEventRequestsWithDefaults[*NewImageCaptionReq](req, NewImageCaptionReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewImageCaptionReq(req NewImageCaptionReq) error {
+	if err := validateInputImage(req.InputImage); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputImage")
+	}
+	return nil
+}
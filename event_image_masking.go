@@ -0,0 +1,157 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Detection models for automatic masking
+const (
+	MaskingModelFaceYoloV8n   = "runware:35@1"
+	MaskingModelFaceYoloV8s   = "runware:35@2"
+	MaskingModelMediapipeFace = "runware:35@3"
+	MaskingModelHandYoloV8n   = "runware:35@10"
+	MaskingModelPersonYoloV8n = "runware:35@12"
+	MaskingModelPersonYoloV8s = "runware:35@13"
+)
+
+// BoundingBox is the area of a detected element, in pixels of the input image
+type BoundingBox struct {
+	XMin int `json:"x_min"`
+	YMin int `json:"y_min"`
+	XMax int `json:"x_max"`
+	YMax int `json:"y_max"`
+}
+
+type NewImageMaskingReq struct {
+	TaskType      string  `json:"taskType"`
+	TaskUUID      string  `json:"taskUUID"`
+	InputImage    string  `json:"inputImage"`
+	Model         string  `json:"model"`
+	Confidence    float64 `json:"confidence,omitempty"`
+	MaxDetections int     `json:"maxDetections,omitempty"`
+	MaskPadding   int     `json:"maskPadding,omitempty"`
+	MaskBlur      int     `json:"maskBlur,omitempty"`
+	OutputType    string  `json:"outputType,omitempty"`
+	OutputFormat  string  `json:"outputFormat,omitempty"`
+	OutputQuality int     `json:"outputQuality,omitempty"`
+	IncludeCost   bool    `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewImageMaskingReq) MarshalJSON() ([]byte, error) {
+	type alias NewImageMaskingReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewImageMaskingResp struct {
+	TaskType            string        `json:"taskType"`
+	TaskUUID            string        `json:"taskUUID"`
+	InputImageUUID      string        `json:"inputImageUUID"`
+	MaskImageUUID       string        `json:"maskImageUUID"`
+	MaskImageURL        string        `json:"maskImageURL,omitempty"`
+	MaskImageBase64Data string        `json:"maskImageBase64Data,omitempty"`
+	MaskImageDataURI    string        `json:"maskImageDataURI,omitempty"`
+	Detections          []BoundingBox `json:"detections"`
+	Cost                float64       `json:"cost,omitempty"`
+	TimedOut            bool          `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewImageMaskingResp) UnmarshalJSON(data []byte) error {
+	type alias NewImageMaskingResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// ImageMasking detects faces, hands or people in an image with the
+// imageMasking task and returns a mask covering them
+func (sdk *SDK) ImageMasking(ctx context.Context, req NewImageMaskingReq) (*NewImageMaskingResp, error) {
+	req = *mergeNewImageMaskingReqWithDefaults(&req)
+	if err := validateNewImageMaskingReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ImageMasking,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newImageMaskingResp := &NewImageMaskingResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newImageMaskingResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newImageMaskingResp.TimedOut = true
+			return newImageMaskingResp, err
+		}
+		return nil, err
+	}
+	
+	return newImageMaskingResp, nil
+}
+
+// InpaintingReq returns an image inference request that repaints the masked
+// area of the input image
+func (resp *NewImageMaskingResp) InpaintingReq(model ModelID, positivePrompt string) NewImageInferenceReq {
+	return NewImageInferenceReq{
+		Model:          model,
+		PositivePrompt: positivePrompt,
+		SeedImage:      resp.InputImageUUID,
+		MaskImage:      resp.MaskImageUUID,
+	}
+}
+
+func NewImageMaskingReqDefaults() *NewImageMaskingReq {
+	return &NewImageMaskingReq{
+		TaskType:      ImageMasking,
+		Model:         MaskingModelFaceYoloV8n,
+		Confidence:    0.25,
+		MaxDetections: 6,
+		MaskPadding:   4,
+		MaskBlur:      4,
+		OutputType:    OutputTypeURL,
+		OutputFormat:  OutputFormatPNG,
+		OutputQuality: 95,
+	}
+}
+
+func mergeNewImageMaskingReqWithDefaults(req *NewImageMaskingReq) *NewImageMaskingReq {
+	_ = MergeEventRequestsWithDefaults[*NewImageMaskingReq](req, NewImageMaskingReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewImageMaskingReq(req NewImageMaskingReq) error {
+	if err := validateInputImage(req.InputImage); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputImage")
+	}
+	
+	if req.Model == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "model")
+	}
+	
+	if req.Confidence < 0 || req.Confidence > 1 {
+		return fmt.Errorf("%w:[%s][0-1]", ErrFieldIncorrectVal, "confidence")
+	}
+	
+	if req.MaxDetections < 1 || req.MaxDetections > 20 {
+		return fmt.Errorf("%w:[%s][1-20]", ErrFieldIncorrectVal, "maxDetections")
+	}
+	
+	if req.MaskPadding < 0 {
+		return fmt.Errorf("%w:[%s][>=0]", ErrFieldIncorrectVal, "maskPadding")
+	}
+	
+	if req.MaskBlur < 0 {
+		return fmt.Errorf("%w:[%s][>=0]", ErrFieldIncorrectVal, "maskBlur")
+	}
+	
+	return validateOutputOptions(req.OutputType, req.OutputFormat, req.OutputQuality)
+}
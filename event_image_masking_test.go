@@ -0,0 +1,106 @@
+package runware
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNewImageMaskingReq(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     NewImageMaskingReq
+		wantErr error
+	}{
+		{
+			name:    "MissingInputImage",
+			req:     NewImageMaskingReq{},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name: "Defaults",
+			req:  NewImageMaskingReq{InputImage: testPNGBase64},
+		},
+		{
+			name:    "ConfidenceAboveOne",
+			req:     NewImageMaskingReq{InputImage: testPNGBase64, Confidence: 1.5},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "NegativeConfidence",
+			req:     NewImageMaskingReq{InputImage: testPNGBase64, Confidence: -0.1},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "NegativeMaskPadding",
+			req:     NewImageMaskingReq{InputImage: testPNGBase64, MaskPadding: -4},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "NegativeMaskBlur",
+			req:     NewImageMaskingReq{InputImage: testPNGBase64, MaskBlur: -4},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "ExplicitZeros",
+			req: NewImageMaskingReq{
+				InputImage: testPNGBase64,
+				Model:      MaskingModelHandYoloV8n,
+				Explicit:   ExplicitFields{"confidence", "maskPadding", "maskBlur"},
+			},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNewImageMaskingReq(*mergeNewImageMaskingReqWithDefaults(&tc.req))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMergeNewImageMaskingReqWithDefaults(t *testing.T) {
+	req := mergeNewImageMaskingReqWithDefaults(&NewImageMaskingReq{
+		InputImage: testPNGBase64,
+		Model:      MaskingModelPersonYoloV8s,
+		MaskBlur:   12,
+	})
+	
+	assert.Equal(t, ImageMasking, req.TaskType)
+	assert.NotEmpty(t, req.TaskUUID)
+	assert.Equal(t, MaskingModelPersonYoloV8s, req.Model)
+	assert.Equal(t, 0.25, req.Confidence)
+	assert.Equal(t, 6, req.MaxDetections)
+	assert.Equal(t, 4, req.MaskPadding)
+	assert.Equal(t, 12, req.MaskBlur)
+}
+
+func TestNewImageMaskingRespUnmarshal(t *testing.T) {
+	data := `{
+		"taskType": "imageMasking",
+		"taskUUID": "t1",
+		"inputImageUUID": "in",
+		"maskImageUUID": "mask",
+		"maskImageURL": "https://example.com/mask.png",
+		"detections": [{"x_min": 10, "y_min": 20, "x_max": 110, "y_max": 140}],
+		"cost": 0.0013
+	}`
+	
+	resp := NewImageMaskingResp{}
+	require.NoError(t, json.Unmarshal([]byte(data), &resp))
+	assert.Equal(t, "mask", resp.MaskImageUUID)
+	assert.Equal(t, "https://example.com/mask.png", resp.MaskImageURL)
+	assert.Equal(t, []BoundingBox{{XMin: 10, YMin: 20, XMax: 110, YMax: 140}}, resp.Detections)
+	assert.Equal(t, 0.0013, resp.Cost)
+	assert.JSONEq(t, data, string(resp.Raw))
+	
+	inpainting := resp.InpaintingReq("runware:100@1", "a smiling face")
+	assert.Equal(t, "in", inpainting.SeedImage)
+	assert.Equal(t, "mask", inpainting.MaskImage)
+}
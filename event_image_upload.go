@@ -3,20 +3,29 @@ package runware
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"strings"
-	"time"
-	
-	"github.com/google/uuid"
 )
 
+// NewImageUploadReq is the request of the legacy newImageUpload event sent by
+// ImageUpload.
+//
+// Deprecated: use NewUploadImageReq with UploadImage.
 type NewImageUploadReq struct {
 	ImageBase64 string `json:"imageBase64"`
 	TaskUUID    string `json:"taskUUID"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewImageUploadReq) MarshalJSON() ([]byte, error) {
+	type alias NewImageUploadReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
 }
 
 type NewImageUploadResp struct {
@@ -24,8 +33,19 @@ type NewImageUploadResp struct {
 	NewImageUUID string `json:"newImageUUID"` // Pointer to handle null values
 	TaskUUID     string `json:"taskUUID"`
 	TimedOut     bool   `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewImageUploadResp) UnmarshalJSON(data []byte) error {
+	type alias NewImageUploadResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
 }
 
+// ImageUpload sends the legacy newImageUpload event.
+//
+// Deprecated: use UploadImage, UploadFile or UploadReader, which run the
+// current imageUpload task and also accept URLs.
 func (sdk *SDK) ImageUpload(ctx context.Context, req NewImageUploadReq) (*NewImageUploadResp, error) {
 	req = *mergeNewControlNetsReqDefaults(&req)
 	if err := validateNewImageUploadReq(req); err != nil {
@@ -33,89 +53,32 @@ func (sdk *SDK) ImageUpload(ctx context.Context, req NewImageUploadReq) (*NewIma
 	}
 	
 	sendReq := Request{
-		ID:            uuid.New().String(),
+		ID:            req.TaskUUID,
 		Event:         NewImageUpload,
 		ResponseEvent: NewUploadedImageUUID,
 		Data:          req,
 	}
 	
 	newImageUploadResp := &NewImageUploadResp{}
-	
-	responseChan := make(chan *NewImageUploadResp)
-	errChan := make(chan error)
-	
-	go func() {
-		defer close(responseChan)
-		defer close(errChan)
-		
-		for msg := range sdk.Client.Listen() {
-			var msgData map[string]interface{}
-			if err := json.Unmarshal(msg, &msgData); err != nil {
-				errChan <- fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
-				return
-			}
-			
-			// Check if is an error message first
-			if errMsg, ok := sdk.OnError(msgData); ok {
-				errChan <- errMsg
-				return
-			}
-			
-			for k, v := range msgData {
-				if k != sendReq.ResponseEvent {
-					log.Println("Skipping event", k, "Currently handling", sendReq.ResponseEvent)
-					continue
-				}
-				
-				bValue, err := interfaceToByte(v)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				err = json.Unmarshal(bValue, &newImageUploadResp)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				responseChan <- newImageUploadResp
-				return
-			}
+	if err := sdk.sendEvent(ctx, sendReq, newImageUploadResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newImageUploadResp.TimedOut = true
+			return newImageUploadResp, err
 		}
-		
-	}()
-	
-	bSendReq, err := sendReq.ToEvent()
-	if err != nil {
-		return nil, err
-	}
-	
-	if err = sdk.Client.Send(bSendReq); err != nil {
 		return nil, err
 	}
 	
-	select {
-	case resp := <-responseChan:
-		return resp, nil
-	case err = <-errChan:
-		return nil, err
-	case <-time.After(timeoutSendResponse * time.Second):
-		newImageUploadResp.TimedOut = true
-		return newImageUploadResp, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
+	return newImageUploadResp, nil
 }
 
 func NewImageUploadReqDefaults() *NewImageUploadReq {
 	return &NewImageUploadReq{
-		TaskUUID: uuid.New().String(),
 	}
 }
 
 func mergeNewControlNetsReqDefaults(req *NewImageUploadReq) *NewImageUploadReq {
 	_ = MergeEventRequestsWithDefaults[*NewImageUploadReq](req, NewImageUploadReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
 	return req
 }
 
@@ -163,20 +126,21 @@ func decodeImage(reader io.Reader) (string, error) {
 		err    error = nil
 	)
 	
-	// Read the first few bytes for format detection
-	header := make([]byte, 8)
-	n, err := reader.Read(header)
-	if err != nil {
+	// Read the first few bytes for format detection. WEBP needs 12 of them:
+	// "RIFF", the chunk size, then "WEBP".
+	header := make([]byte, 16)
+	n, err := io.ReadFull(reader, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
 		return format, fmt.Errorf("%w: [%s]", ErrImageHeader, err.Error())
 	}
 	if n < 8 {
 		return format, fmt.Errorf("%w: [%s]", ErrImageHeader, "insufficient image data")
 	}
+	header = header[:n]
 	
 	// TODO: Uncomment the rest as they become supported
 	switch {
-	case bytes.HasPrefix(header, []byte{0x52, 0x49, 0x46, 0x46}) &&
-		bytes.Contains(header, []byte("WEBPVP8")): // WEBP
+	case n >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP")): // WEBP
 		format = "webp"
 	case bytes.HasPrefix(header, []byte{0xFF, 0xD8, 0xFF}): // JPEG
 		format = "jpeg"
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 	
 	"github.com/stretchr/testify/assert"
@@ -123,6 +124,70 @@ func (s *ImageUploadSuite) TestIsValidBase64Image() {
 	}
 }
 
+func (s *ImageUploadSuite) TestValidateNewUploadImageReq() {
+	testCases := []struct {
+		name    string
+		req     NewUploadImageReq
+		wantErr error
+	}{
+		{
+			name:    "EmptyImage",
+			req:     NewUploadImageReq{},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name: "URL",
+			req: NewUploadImageReq{
+				Image: "https://example.com/image.png",
+			},
+		},
+		{
+			name: "DataURI",
+			req: NewUploadImageReq{
+				Image: "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII=",
+			},
+		},
+		{
+			name: "ImageUUIDIsNotUploadable",
+			req: NewUploadImageReq{
+				Image: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+			},
+			wantErr: ErrImageIsNotBase64,
+		},
+	}
+	
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := validateNewUploadImageReq(tc.req)
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tc.wantErr), "Error should wrap the expected error")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *ImageUploadSuite) TestReaderToDataURI() {
+	png := []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00}
+	
+	got, err := readerToDataURI(bytes.NewReader(png))
+	s.NoError(err)
+	s.Equal("data:image/png;base64,iVBORw0KGgoA", got)
+	
+	webp := append([]byte("RIFF\x24\x00\x00\x00WEBPVP8 "), make([]byte, 16)...)
+	got, err = readerToDataURI(bytes.NewReader(webp))
+	s.NoError(err)
+	s.True(strings.HasPrefix(got, "data:image/webp;base64,"))
+	
+	_, err = readerToDataURI(bytes.NewReader([]byte("RIFF\x24\x00\x00\x00WAVEfmt ")))
+	s.True(errors.Is(err, ErrImageUnsupported))
+	
+	_, err = readerToDataURI(bytes.NewReader([]byte("GIF89a-not-supported")))
+	s.True(errors.Is(err, ErrImageUnsupported))
+}
+
 // Run the test suite
 func TestImageUploadSuite(t *testing.T) {
 	suite.Run(t, new(ImageUploadSuite))
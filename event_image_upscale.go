@@ -0,0 +1,105 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type NewImageUpscaleReq struct {
+	TaskType      string `json:"taskType"`
+	TaskUUID      string `json:"taskUUID"`
+	InputImage    string `json:"inputImage"`
+	UpscaleFactor int    `json:"upscaleFactor"`
+	OutputType    string `json:"outputType,omitempty"`
+	OutputFormat  string `json:"outputFormat,omitempty"`
+	OutputQuality int    `json:"outputQuality,omitempty"`
+	IncludeCost   bool   `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewImageUpscaleReq) MarshalJSON() ([]byte, error) {
+	type alias NewImageUpscaleReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewImageUpscaleResp struct {
+	TaskType        string  `json:"taskType"`
+	TaskUUID        string  `json:"taskUUID"`
+	InputImageUUID  string  `json:"inputImageUUID"`
+	ImageUUID       string  `json:"imageUUID"`
+	ImageURL        string  `json:"imageURL,omitempty"`
+	ImageBase64Data string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    string  `json:"imageDataURI,omitempty"`
+	Cost            float64 `json:"cost,omitempty"`
+	TimedOut        bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewImageUpscaleResp) UnmarshalJSON(data []byte) error {
+	type alias NewImageUpscaleResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// Upscale enlarges an image by UpscaleFactor using the imageUpscale task
+func (sdk *SDK) Upscale(ctx context.Context, req NewImageUpscaleReq) (*NewImageUpscaleResp, error) {
+	req = *mergeNewImageUpscaleReqWithDefaults(&req)
+	if err := validateNewImageUpscaleReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ImageUpscale,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newImageUpscaleResp := &NewImageUpscaleResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newImageUpscaleResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newImageUpscaleResp.TimedOut = true
+			return newImageUpscaleResp, err
+		}
+		return nil, err
+	}
+	
+	return newImageUpscaleResp, nil
+}
+
+func NewImageUpscaleReqDefaults() *NewImageUpscaleReq {
+	return &NewImageUpscaleReq{
+		TaskType:      ImageUpscale,
+		OutputType:    OutputTypeURL,
+		OutputFormat:  OutputFormatJPG,
+		OutputQuality: 95,
+	}
+}
+
+func mergeNewImageUpscaleReqWithDefaults(req *NewImageUpscaleReq) *NewImageUpscaleReq {
+	_ = MergeEventRequestsWithDefaults[*NewImageUpscaleReq](req, NewImageUpscaleReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewImageUpscaleReq(req NewImageUpscaleReq) error {
+	if err := validateInputImage(req.InputImage); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputImage")
+	}
+	
+	if req.UpscaleFactor == 0 {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "upscaleFactor")
+	}
+	
+	if req.UpscaleFactor < 2 || req.UpscaleFactor > 4 {
+		return fmt.Errorf("%w:[%s][2-4]", ErrFieldIncorrectVal, "upscaleFactor")
+	}
+	
+	return validateOutputOptions(req.OutputType, req.OutputFormat, req.OutputQuality)
+}
@@ -0,0 +1,93 @@
+package runware
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNewImageUpscaleReq(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     NewImageUpscaleReq
+		wantErr error
+	}{
+		{
+			name:    "MissingInputImage",
+			req:     NewImageUpscaleReq{UpscaleFactor: 2},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name:    "MissingUpscaleFactor",
+			req:     NewImageUpscaleReq{InputImage: testPNGBase64},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name:    "UpscaleFactorOutOfRange",
+			req:     NewImageUpscaleReq{InputImage: testPNGBase64, UpscaleFactor: 8},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "ImageUUID",
+			req:  NewImageUpscaleReq{InputImage: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10", UpscaleFactor: 4},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNewImageUpscaleReq(*mergeNewImageUpscaleReqWithDefaults(&tc.req))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMergeNewImageUpscaleReqWithDefaults(t *testing.T) {
+	req := mergeNewImageUpscaleReqWithDefaults(&NewImageUpscaleReq{
+		InputImage:    testPNGBase64,
+		UpscaleFactor: 2,
+		OutputFormat:  OutputFormatPNG,
+	})
+	
+	assert.Equal(t, ImageUpscale, req.TaskType)
+	assert.NotEmpty(t, req.TaskUUID)
+	assert.Equal(t, OutputTypeURL, req.OutputType)
+	assert.Equal(t, OutputFormatPNG, req.OutputFormat)
+	assert.Equal(t, 95, req.OutputQuality)
+}
+
+func TestNewImageUpscaleReqMarshal(t *testing.T) {
+	b, err := json.Marshal(NewImageUpscaleReq{
+		TaskType:      ImageUpscale,
+		TaskUUID:      "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		InputImage:    "https://example.com/photo.jpg",
+		UpscaleFactor: 4,
+		Extra:         map[string]any{"faceEnhance": true},
+	})
+	require.NoError(t, err)
+	
+	assert.JSONEq(t, `{
+		"taskType": "imageUpscale",
+		"taskUUID": "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		"inputImage": "https://example.com/photo.jpg",
+		"upscaleFactor": 4,
+		"faceEnhance": true
+	}`, string(b))
+}
+
+func TestNewImageUpscaleRespUnmarshal(t *testing.T) {
+	data := `{"taskType":"imageUpscale","taskUUID":"t1","inputImageUUID":"in","imageUUID":"out","imageURL":"https://example.com/out.jpg","cost":0.002,"upscaler":"v2"}`
+	
+	resp := NewImageUpscaleResp{}
+	require.NoError(t, json.Unmarshal([]byte(data), &resp))
+	assert.Equal(t, "in", resp.InputImageUUID)
+	assert.Equal(t, "out", resp.ImageUUID)
+	assert.Equal(t, "https://example.com/out.jpg", resp.ImageURL)
+	assert.Equal(t, 0.002, resp.Cost)
+	assert.JSONEq(t, data, string(resp.Raw))
+}
@@ -0,0 +1,224 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type NewModelSearchReq struct {
+	TaskType     string   `json:"taskType"`
+	TaskUUID     string   `json:"taskUUID"`
+	Search       string   `json:"search,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Category     string   `json:"category,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Architecture string   `json:"architecture,omitempty"`
+	Conditioning string   `json:"conditioning,omitempty"`
+	Visibility   string   `json:"visibility,omitempty"`
+	Limit        int      `json:"limit,omitempty"`
+	Offset       int      `json:"offset,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewModelSearchReq) MarshalJSON() ([]byte, error) {
+	type alias NewModelSearchReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+// ModelRecord describes a model returned by the modelSearch task
+type ModelRecord struct {
+	AIR                  string   `json:"air"`
+	Name                 string   `json:"name"`
+	Version              string   `json:"version"`
+	Category             string   `json:"category"`
+	Architecture         string   `json:"architecture"`
+	Type                 string   `json:"type,omitempty"`
+	Tags                 []string `json:"tags,omitempty"`
+	HeroImage            string   `json:"heroImage,omitempty"`
+	Private              bool     `json:"private"`
+	Comment              string   `json:"comment,omitempty"`
+	Conditioning         string   `json:"conditioning,omitempty"`
+	DefaultWidth         int      `json:"defaultWidth,omitempty"`
+	DefaultHeight        int      `json:"defaultHeight,omitempty"`
+	DefaultSteps         int      `json:"defaultSteps,omitempty"`
+	DefaultScheduler     string   `json:"defaultScheduler,omitempty"`
+	DefaultCFG           float64  `json:"defaultCFG,omitempty"`
+	DefaultStrength      float64  `json:"defaultStrength,omitempty"`
+	DefaultWeight        float64  `json:"defaultWeight,omitempty"`
+	PositiveTriggerWords string   `json:"positiveTriggerWords,omitempty"`
+}
+
+type NewModelSearchResp struct {
+	TaskType     string        `json:"taskType"`
+	TaskUUID     string        `json:"taskUUID"`
+	Results      []ModelRecord `json:"results"`
+	TotalResults int           `json:"totalResults"`
+	TimedOut     bool          `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewModelSearchResp) UnmarshalJSON(data []byte) error {
+	type alias NewModelSearchResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// ModelSearch returns a single page of models matching the filters of req
+func (sdk *SDK) ModelSearch(ctx context.Context, req NewModelSearchReq) (*NewModelSearchResp, error) {
+	req = *mergeNewModelSearchReqWithDefaults(&req)
+	if err := validateNewModelSearchReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ModelSearch,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newModelSearchResp := &NewModelSearchResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newModelSearchResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newModelSearchResp.TimedOut = true
+			return newModelSearchResp, err
+		}
+		return nil, err
+	}
+	
+	return newModelSearchResp, nil
+}
+
+// ModelIterator walks over every model matching a search, fetching the next
+// page only when the current one is exhausted.
+//
+//	it := sdk.ModelSearchIter(ctx, runware.NewModelSearchReq{Category: runware.ModelCategoryLora})
+//	for it.Next() {
+//		fmt.Println(it.Model().AIR)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ModelIterator struct {
+	sdk *SDK
+	ctx context.Context
+	req NewModelSearchReq
+
+	page    []ModelRecord
+	index   int
+	fetched int
+	total   int
+	started bool
+	err     error
+}
+
+// ModelSearchIter returns an iterator over all models matching req. Limit
+// sets the page size and Offset the position of the first model.
+func (sdk *SDK) ModelSearchIter(ctx context.Context, req NewModelSearchReq) *ModelIterator {
+	return &ModelIterator{
+		sdk:   sdk,
+		ctx:   ctx,
+		req:   req,
+		index: -1,
+	}
+}
+
+// Next advances to the next model, fetching a new page when needed. It
+// returns false when all models have been read or an error occurred.
+func (it *ModelIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	
+	if it.index+1 < len(it.page) {
+		it.index++
+		return true
+	}
+	
+	if it.started && (len(it.page) == 0 || it.req.Offset+it.fetched >= it.total) {
+		return false
+	}
+	
+	// Each page is a new task
+	req := it.req
+	req.TaskUUID = ""
+	req.Offset = it.req.Offset + it.fetched
+	
+	resp, err := it.sdk.ModelSearch(it.ctx, req)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	
+	it.started = true
+	it.page = resp.Results
+	it.fetched += len(resp.Results)
+	it.total = resp.TotalResults
+	it.index = 0
+	
+	return len(it.page) > 0
+}
+
+// Model returns the current model. It is only valid after Next returned true.
+func (it *ModelIterator) Model() ModelRecord {
+	return it.page[it.index]
+}
+
+// Total returns the number of matching models reported by the last page
+func (it *ModelIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ModelIterator) Err() error {
+	return it.err
+}
+
+func NewModelSearchReqDefaults() *NewModelSearchReq {
+	return &NewModelSearchReq{
+		TaskType: ModelSearch,
+		Limit:    20,
+	}
+}
+
+func mergeNewModelSearchReqWithDefaults(req *NewModelSearchReq) *NewModelSearchReq {
+	_ = MergeEventRequestsWithDefaults[*NewModelSearchReq](req, NewModelSearchReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewModelSearchReq(req NewModelSearchReq) error {
+	switch req.Category {
+	case "", ModelCategoryCheckpoint, ModelCategoryLora, ModelCategoryLycoris,
+		ModelCategoryControlNet, ModelCategoryVAE, ModelCategoryEmbeddings:
+	default:
+		return fmt.Errorf("%w:[%s]", ErrFieldIncorrectVal, "category")
+	}
+	
+	if req.Type != "" && req.Category != ModelCategoryCheckpoint {
+		return fmt.Errorf("%w:[%s is only supported for %s models]", ErrFieldIncorrectVal, "type", ModelCategoryCheckpoint)
+	}
+	
+	switch req.Visibility {
+	case "", ModelVisibilityPublic, ModelVisibilityPrivate, ModelVisibilityAll:
+	default:
+		return fmt.Errorf("%w:[%s][%s, %s, %s]", ErrFieldIncorrectVal, "visibility",
+			ModelVisibilityPublic, ModelVisibilityPrivate, ModelVisibilityAll)
+	}
+	
+	if req.Limit < 1 || req.Limit > 100 {
+		return fmt.Errorf("%w:[%s][1-100]", ErrFieldIncorrectVal, "limit")
+	}
+	
+	if req.Offset < 0 {
+		return fmt.Errorf("%w:[%s][>=0]", ErrFieldIncorrectVal, "offset")
+	}
+	
+	return nil
+}
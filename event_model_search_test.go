@@ -0,0 +1,66 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelSearchIter(t *testing.T) {
+	listen := make(chan []byte, 1)
+	var offsets []float64
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			offset, _ := sent[0]["offset"].(float64)
+			offsets = append(offsets, offset)
+			
+			// Five models in total, served two per page
+			var results []ModelRecord
+			for i := int(offset); i < int(offset)+2 && i < 5; i++ {
+				results = append(results, ModelRecord{AIR: fmt.Sprintf("civitai:%d@1", i)})
+			}
+			bResults, _ := json.Marshal(results)
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"modelSearch","taskUUID":"%s","results":%s,"totalResults":5}]}`,
+				sent[0]["taskUUID"], bResults))
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	it := sdk.ModelSearchIter(context.Background(), NewModelSearchReq{
+		Category: ModelCategoryLora,
+		Limit:    2,
+	})
+	
+	var airs []string
+	for it.Next() {
+		airs = append(airs, it.Model().AIR)
+	}
+	
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"civitai:0@1", "civitai:1@1", "civitai:2@1", "civitai:3@1", "civitai:4@1"}, airs)
+	assert.Equal(t, []float64{0, 2, 4}, offsets)
+	assert.Equal(t, 5, it.Total())
+}
+
+func TestValidateNewModelSearchReq(t *testing.T) {
+	assert.NoError(t, validateNewModelSearchReq(*mergeNewModelSearchReqWithDefaults(&NewModelSearchReq{
+		Category: ModelCategoryCheckpoint,
+		Type:     ModelTypeInpainting,
+	})))
+	assert.ErrorIs(t, validateNewModelSearchReq(NewModelSearchReq{Category: "unknown", Limit: 20}), ErrFieldIncorrectVal)
+	assert.ErrorIs(t, validateNewModelSearchReq(NewModelSearchReq{Type: ModelTypeBase, Limit: 20}), ErrFieldIncorrectVal)
+	assert.ErrorIs(t, validateNewModelSearchReq(NewModelSearchReq{Limit: 101}), ErrFieldIncorrectVal)
+}
@@ -0,0 +1,241 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Model upload statuses reported while the server processes an upload
+const (
+	ModelUploadStatusValidated   = "validated"
+	ModelUploadStatusDownloading = "downloading"
+	ModelUploadStatusDownloaded  = "downloaded"
+	ModelUploadStatusOptimizing  = "optimizing"
+	ModelUploadStatusOptimized   = "optimized"
+	ModelUploadStatusUploading   = "uploading"
+	ModelUploadStatusUploaded    = "uploaded"
+	ModelUploadStatusReady       = "ready"
+)
+
+type NewModelUploadReq struct {
+	TaskType         string   `json:"taskType"`
+	TaskUUID         string   `json:"taskUUID"`
+	Category         string   `json:"category"`
+	Architecture     string   `json:"architecture"`
+	Format           string   `json:"format"`
+	AIR              string   `json:"air"`
+	UniqueIdentifier string   `json:"uniqueIdentifier"`
+	Name             string   `json:"name"`
+	Version          string   `json:"version"`
+	DownloadURL      string   `json:"downloadURL"`
+	Private          bool     `json:"private"`
+	HeroImageURL     string   `json:"heroImageURL,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	ShortDescription string   `json:"shortDescription,omitempty"`
+	Comment          string   `json:"comment,omitempty"`
+
+	// Checkpoint defaults
+	Type             string  `json:"type,omitempty"`
+	DefaultWidth     int     `json:"defaultWidth,omitempty"`
+	DefaultHeight    int     `json:"defaultHeight,omitempty"`
+	DefaultSteps     int     `json:"defaultSteps,omitempty"`
+	DefaultScheduler string  `json:"defaultScheduler,omitempty"`
+	DefaultCFG       float64 `json:"defaultCFG,omitempty"`
+	DefaultStrength  float64 `json:"defaultStrength,omitempty"`
+
+	// LoRA defaults
+	PositiveTriggerWords string  `json:"positiveTriggerWords,omitempty"`
+	DefaultWeight        float64 `json:"defaultWeight,omitempty"`
+
+	// ControlNet conditioning, e.g. canny, depth or openpose
+	Conditioning string `json:"conditioning,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewModelUploadReq) MarshalJSON() ([]byte, error) {
+	type alias NewModelUploadReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+// ModelUploadStatus is a single status message emitted during a model upload
+type ModelUploadStatus struct {
+	TaskType string `json:"taskType"`
+	TaskUUID string `json:"taskUUID"`
+	AIR      string `json:"air,omitempty"`
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+}
+
+type NewModelUploadResp struct {
+	TaskType string              `json:"taskType"`
+	TaskUUID string              `json:"taskUUID"`
+	AIR      string              `json:"air"`
+	Status   string              `json:"status"`
+	Statuses []ModelUploadStatus `json:"statuses"`
+	TimedOut bool                `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// UploadModel registers a checkpoint, LoRA or ControlNet model hosted at
+// DownloadURL using the modelUpload task. The server reports several stages
+// while it downloads and stores the file; each of them is passed to onStatus
+// (which may be nil) and UploadModel returns once the model is ready.
+func (sdk *SDK) UploadModel(ctx context.Context, req NewModelUploadReq, onStatus func(ModelUploadStatus)) (*NewModelUploadResp, error) {
+	req = *mergeNewModelUploadReqWithDefaults(&req)
+	if err := validateNewModelUploadReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ModelUpload,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newModelUploadResp := &NewModelUploadResp{
+		TaskType: ModelUpload,
+		TaskUUID: req.TaskUUID,
+		AIR:      req.AIR,
+	}
+	
+	var items [][]byte
+	err := sdk.streamTask(ctx, sendReq, req.TaskUUID, func(item []byte) (bool, error) {
+		var status ModelUploadStatus
+		if err := json.Unmarshal(item, &status); err != nil {
+			return false, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+		}
+		
+		items = append(items, item)
+		newModelUploadResp.Raw = rawArray(items)
+		
+		newModelUploadResp.Status = status.Status
+		newModelUploadResp.Statuses = append(newModelUploadResp.Statuses, status)
+		if status.AIR != "" {
+			newModelUploadResp.AIR = status.AIR
+		}
+		
+		if onStatus != nil {
+			onStatus(status)
+		}
+		
+		return status.Status == ModelUploadStatusReady, nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newModelUploadResp.TimedOut = true
+			return newModelUploadResp, err
+		}
+		return nil, err
+	}
+	
+	return newModelUploadResp, nil
+}
+
+func NewModelUploadReqDefaults() *NewModelUploadReq {
+	return &NewModelUploadReq{
+		TaskType: ModelUpload,
+	}
+}
+
+func mergeNewModelUploadReqWithDefaults(req *NewModelUploadReq) *NewModelUploadReq {
+	_ = MergeEventRequestsWithDefaults[*NewModelUploadReq](req, NewModelUploadReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewModelUploadReq(req NewModelUploadReq) error {
+	required := []struct {
+		field string
+		value string
+	}{
+		{"category", req.Category},
+		{"architecture", req.Architecture},
+		{"format", req.Format},
+		{"air", req.AIR},
+		{"uniqueIdentifier", req.UniqueIdentifier},
+		{"name", req.Name},
+		{"version", req.Version},
+		{"downloadURL", req.DownloadURL},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			return fmt.Errorf("%w:[%s]", ErrFieldRequired, r.field)
+		}
+	}
+	
+	if !isValidAIR(req.AIR) {
+		return fmt.Errorf("%w:[%s][source:id@version]", ErrFieldIncorrectVal, "air")
+	}
+	
+	if !isImageURL(req.DownloadURL) {
+		return fmt.Errorf("%w:[%s][http(s) URL]", ErrFieldIncorrectVal, "downloadURL")
+	}
+	
+	switch req.Format {
+	case ModelFormatSafetensors, ModelFormatCkpt:
+	default:
+		return fmt.Errorf("%w:[%s][%s, %s]", ErrFieldIncorrectVal, "format", ModelFormatSafetensors, ModelFormatCkpt)
+	}
+	
+	switch req.Category {
+	case ModelCategoryCheckpoint:
+		return validateCheckpointUpload(req)
+	case ModelCategoryLora, ModelCategoryLycoris:
+		if req.PositiveTriggerWords == "" {
+			return fmt.Errorf("%w:[%s]", ErrFieldRequired, "positiveTriggerWords")
+		}
+		if req.DefaultWeight < -4 || req.DefaultWeight > 4 {
+			return fmt.Errorf("%w:[%s][-4-4]", ErrFieldIncorrectVal, "defaultWeight")
+		}
+	case ModelCategoryControlNet:
+		if req.Conditioning == "" {
+			return fmt.Errorf("%w:[%s]", ErrFieldRequired, "conditioning")
+		}
+	default:
+		return fmt.Errorf("%w:[%s][%s, %s, %s, %s]", ErrFieldIncorrectVal, "category",
+			ModelCategoryCheckpoint, ModelCategoryLora, ModelCategoryLycoris, ModelCategoryControlNet)
+	}
+	
+	return nil
+}
+
+func validateCheckpointUpload(req NewModelUploadReq) error {
+	switch req.Type {
+	case ModelTypeBase, ModelTypeInpainting, ModelTypeRefiner:
+	case "":
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "type")
+	default:
+		return fmt.Errorf("%w:[%s][%s, %s, %s]", ErrFieldIncorrectVal, "type",
+			ModelTypeBase, ModelTypeInpainting, ModelTypeRefiner)
+	}
+	
+	if req.DefaultScheduler == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "defaultScheduler")
+	}
+	
+	if req.DefaultSteps < 1 || req.DefaultSteps > 100 {
+		return fmt.Errorf("%w:[%s][1-100]", ErrFieldIncorrectVal, "defaultSteps")
+	}
+	
+	if req.DefaultCFG < 0 || req.DefaultCFG > 50 {
+		return fmt.Errorf("%w:[%s][0-50]", ErrFieldIncorrectVal, "defaultCFG")
+	}
+	
+	if req.DefaultStrength < 0 || req.DefaultStrength > 1 {
+		return fmt.Errorf("%w:[%s][0-1]", ErrFieldIncorrectVal, "defaultStrength")
+	}
+	
+	if req.DefaultWidth%64 != 0 || req.DefaultHeight%64 != 0 {
+		return fmt.Errorf("%w:[%s][divisible by 64]", ErrFieldIncorrectVal, "defaultWidth/defaultHeight")
+	}
+	
+	return nil
+}
@@ -0,0 +1,85 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validLoraUpload() NewModelUploadReq {
+	return NewModelUploadReq{
+		Category:             ModelCategoryLora,
+		Architecture:         ModelArchitectureSDXL,
+		Format:               ModelFormatSafetensors,
+		AIR:                  "myorg:42@1",
+		UniqueIdentifier:     "f3b1c2d4e5f60718293a4b5c6d7e8f90",
+		Name:                 "Product shots",
+		Version:              "1.0",
+		DownloadURL:          "https://example.com/lora.safetensors",
+		PositiveTriggerWords: "prodshot",
+		DefaultWeight:        0.8,
+	}
+}
+
+func TestValidateNewModelUploadReq(t *testing.T) {
+	assert.NoError(t, validateNewModelUploadReq(validLoraUpload()))
+	
+	req := validLoraUpload()
+	req.AIR = "myorg-42"
+	assert.ErrorIs(t, validateNewModelUploadReq(req), ErrFieldIncorrectVal)
+	
+	req = validLoraUpload()
+	req.PositiveTriggerWords = ""
+	assert.ErrorIs(t, validateNewModelUploadReq(req), ErrFieldRequired)
+	
+	req = validLoraUpload()
+	req.Category = ModelCategoryCheckpoint
+	assert.ErrorIs(t, validateNewModelUploadReq(req), ErrFieldRequired)
+	
+	req.Type = ModelTypeBase
+	req.DefaultScheduler = "Euler"
+	req.DefaultSteps = 30
+	req.DefaultCFG = 7
+	assert.NoError(t, validateNewModelUploadReq(req))
+	
+	req = validLoraUpload()
+	req.Category = ModelCategoryControlNet
+	assert.ErrorIs(t, validateNewModelUploadReq(req), ErrFieldRequired)
+}
+
+func TestUploadModelStatuses(t *testing.T) {
+	listen := make(chan []byte, 4)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			for _, status := range []string{ModelUploadStatusValidated, ModelUploadStatusDownloading, ModelUploadStatusReady} {
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"modelUpload","taskUUID":"%s","status":"%s","air":"myorg:42@1"}]}`,
+					sent[0]["taskUUID"], status))
+			}
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	var seen []string
+	resp, err := sdk.UploadModel(context.Background(), validLoraUpload(), func(status ModelUploadStatus) {
+		seen = append(seen, status.Status)
+	})
+	
+	require.NoError(t, err)
+	assert.Equal(t, []string{ModelUploadStatusValidated, ModelUploadStatusDownloading, ModelUploadStatusReady}, seen)
+	assert.Equal(t, ModelUploadStatusReady, resp.Status)
+	assert.Len(t, resp.Statuses, 3)
+	assert.Equal(t, "myorg:42@1", resp.AIR)
+}
@@ -3,9 +3,9 @@ package runware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"time"
+	"strings"
 	
 	"github.com/google/uuid"
 )
@@ -30,7 +30,7 @@ type NewImageInferenceReq struct {
 	// Core generation parameters
 	PositivePrompt string `json:"positivePrompt"`
 	NegativePrompt string `json:"negativePrompt,omitempty"`
-	Model          string `json:"model"`
+	Model          ModelID `json:"model"`
 	Width          int    `json:"width"`
 	Height         int    `json:"height"`
 
@@ -49,7 +49,7 @@ type NewImageInferenceReq struct {
 	ClipSkip           *int    `json:"clipSkip,omitempty"`
 	PromptWeighting    string  `json:"promptWeighting,omitempty"`
 	NumberResults      int     `json:"numberResults,omitempty"`
-	VAE                string  `json:"vae,omitempty"`
+	VAE                ModelID `json:"vae,omitempty"`
 
 	// Outpainting
 	Outpaint *Outpaint `json:"outpaint,omitempty"`
@@ -73,6 +73,23 @@ type NewImageInferenceReq struct {
 
 	// Provider-specific settings
 	ProviderSettings *ProviderSettings `json:"providerSettings,omitempty"`
+
+	// Architecture selects the validation profile, see ProfileFor. It is
+	// detected from Model when empty and never sent.
+	Architecture string `json:"-"`
+
+	// Explicit lists the fields set on purpose to their zero value, such as a
+	// strength or CFGScale of 0, so defaults do not replace them
+	Explicit ExplicitFields `json:"-"`
+
+	// Extra holds parameters the SDK does not model yet. They are merged into
+	// the task payload and replace modelled fields of the same name.
+	Extra map[string]any `json:"-"`
+}
+
+func (req NewImageInferenceReq) MarshalJSON() ([]byte, error) {
+	type alias NewImageInferenceReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
 }
 
 type NewImageInferenceResp struct {
@@ -86,6 +103,15 @@ type NewImageInferenceResp struct {
 	NSFWContent     bool    `json:"NSFWContent,omitempty"`
 	Cost            float64 `json:"cost,omitempty"`
 	TimedOut        bool    `json:"timedOut"`
+
+	// Raw is the payload the response was decoded from, including fields the
+	// SDK does not model
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewImageInferenceResp) UnmarshalJSON(data []byte) error {
+	type alias NewImageInferenceResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
 }
 
 func (sdk *SDK) ImageInference(ctx context.Context, req NewImageInferenceReq) (*NewImageInferenceResp, error) {
@@ -94,91 +120,38 @@ func (sdk *SDK) ImageInference(ctx context.Context, req NewImageInferenceReq) (*
 		return nil, err
 	}
 	
-	sendReq := Request{
-		ID:            uuid.New().String(),
-		Event:         NewTask,
-		ResponseEvent: NewImage,
-		Data:          []NewImageInferenceReq{req},
-	}
-	
 	newImageInferenceResp := &NewImageInferenceResp{}
 	
-	responseChan := make(chan *NewImageInferenceResp)
-	errChan := make(chan error)
-	
-	go func() {
-		defer close(responseChan)
-		defer close(errChan)
-		
-		for msg := range sdk.Client.Listen() {
-			var msgData map[string]interface{}
-			if err := json.Unmarshal(msg, &msgData); err != nil {
-				errChan <- fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
-				return
-			}
-			
-			// Check if is an error message first
-			if errMsg, ok := sdk.OnError(msgData); ok {
-				errChan <- errMsg
-				return
-			}
-			
-			for k, v := range msgData {
-				if k != sendReq.ResponseEvent {
-					log.Println("Skipping event", k, "Currently handling", sendReq.ResponseEvent)
-					continue
-				}
-				
-				// Handle array response
-				vArray, ok := v.([]interface{})
-				if !ok || len(vArray) == 0 {
-					continue
-				}
-				
-				bValue, err := interfaceToByte(vArray[0])
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				err = json.Unmarshal(bValue, &newImageInferenceResp)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				responseChan <- newImageInferenceResp
-				return
-			}
-		}
-	}()
-	
-	bSendReq, err := sendReq.ToEvent()
-	if err != nil {
-		return nil, err
+	var err error
+	if sdk.legacy {
+		err = sdk.sendEvent(ctx, Request{
+			ID:            req.TaskUUID,
+			Event:         NewTask,
+			ResponseEvent: NewImage,
+			Data:          []NewImageInferenceReq{req},
+		}, newImageInferenceResp)
+	} else {
+		err = sdk.sendTask(ctx, Request{
+			ID:            uuid.New().String(),
+			Event:         ImageInference,
+			ResponseEvent: ResponseData,
+			Data:          req,
+		}, req.TaskUUID, newImageInferenceResp)
 	}
-	
-	if err = sdk.Client.Send(bSendReq); err != nil {
+	if err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newImageInferenceResp.TimedOut = true
+			return newImageInferenceResp, err
+		}
 		return nil, err
 	}
 	
-	select {
-	case resp := <-responseChan:
-		return resp, nil
-	case err = <-errChan:
-		return nil, err
-	case <-time.After(timeoutSendResponse * time.Second):
-		newImageInferenceResp.TimedOut = true
-		return newImageInferenceResp, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	}
+	return newImageInferenceResp, nil
 }
 
 func NewImageInferenceReqDefaults() *NewImageInferenceReq {
 	return &NewImageInferenceReq{
 		TaskType:       ImageInference,
-		TaskUUID:       uuid.New().String(),
 		DeliveryMethod: DeliveryMethodSync,
 		OutputType:     OutputTypeURL,
 		OutputFormat:   OutputFormatJPG,
@@ -190,80 +163,199 @@ func NewImageInferenceReqDefaults() *NewImageInferenceReq {
 	}
 }
 
+// imageInferenceTask returns the kind of task req describes, which decides
+// the defaults that apply to it
+func imageInferenceTask(req NewImageInferenceReq) string {
+	switch {
+	case req.MaskImage != "":
+		return Inpainting
+	case req.SeedImage != "":
+		return ImageToImage
+	default:
+		return TextToImage
+	}
+}
+
+// imageInferenceDefaults returns the defaults of a kind of image inference
+// task. Strength only applies when a seed image is transformed; image to image
+// and inpainting otherwise share the same defaults, as they do on the API.
+func imageInferenceDefaults(task string) *NewImageInferenceReq {
+	defaults := NewImageInferenceReqDefaults()
+	if task == TextToImage {
+		defaults.Strength = 0
+	}
+	return defaults
+}
+
 func mergeImageInferenceReqWithDefaults(req *NewImageInferenceReq) *NewImageInferenceReq {
-	_ = MergeEventRequestsWithDefaults[*NewImageInferenceReq](req, NewImageInferenceReqDefaults())
+	_ = MergeEventRequestsWithDefaults[*NewImageInferenceReq](req, imageInferenceDefaults(imageInferenceTask(*req)))
+	ensureTaskUUID(&req.TaskUUID)
 	return req
 }
 
+// validateImageInferenceReq reports every invalid field of req at once as a
+// *ValidationError
 func validateImageInferenceReq(req NewImageInferenceReq) error {
+	v := &validation{}
+	
 	if req.PositivePrompt == "" {
-		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "positivePrompt")
+		v.required("positivePrompt", "")
 	}
 	
 	if req.Model == "" {
-		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "model")
+		v.required("model", "")
 	}
+	req.Model.check(v, "model")
 	
-	if req.Width < 128 || req.Width > 2048 || req.Width%64 != 0 {
-		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "width")
-	}
-	
-	if req.Height < 128 || req.Height > 2048 || req.Height%64 != 0 {
-		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "height")
-	}
-	
-	if req.Steps < 1 || req.Steps > 100 {
-		return fmt.Errorf("%w:[%s][1-100]", ErrFieldIncorrectVal, "steps")
-	}
-	
-	if req.CFGScale < 0 || req.CFGScale > 50 {
-		return fmt.Errorf("%w:[%s][0-50]", ErrFieldIncorrectVal, "CFGScale")
-	}
+	profile := imageInferenceProfile(req)
+	profile.checkSize(v, req.Width, req.Height)
+	profile.checkSteps(v, req.Steps)
+	profile.checkCFGScale(v, req.CFGScale)
+	profile.checkScheduler(v, req.Scheduler)
+	profile.checkFeatures(v, req)
 	
 	if req.ClipSkip != nil && (*req.ClipSkip < 0 || *req.ClipSkip > 2) {
-		return fmt.Errorf("%w:[%s][0-2]", ErrFieldIncorrectVal, "clipSkip")
+		v.incorrect("clipSkip", "0-2", *req.ClipSkip)
 	}
 	
 	if req.OutputQuality < 20 || req.OutputQuality > 99 {
-		return fmt.Errorf("%w:[%s][20-99]", ErrFieldIncorrectVal, "outputQuality")
+		v.incorrect("outputQuality", "20-99", req.OutputQuality)
 	}
 	
 	if req.NumberResults < 1 || req.NumberResults > 20 {
-		return fmt.Errorf("%w:[%s][1-20]", ErrFieldIncorrectVal, "numberResults")
+		v.incorrect("numberResults", "1-20", req.NumberResults)
 	}
 	
 	if req.Strength < 0 || req.Strength > 1 {
-		return fmt.Errorf("%w:[%s][0-1]", ErrFieldIncorrectVal, "strength")
+		v.incorrect("strength", "0-1", req.Strength)
 	}
 	
 	// Validate workflow-specific requirements
-	if req.SeedImage != "" && req.Strength == 0 {
-		req.Strength = 0.8
-	}
-	
 	if req.MaskImage != "" && req.SeedImage == "" {
-		return fmt.Errorf("%w:[%s when maskImage is provided]", ErrFieldRequired, "seedImage")
+		v.required("seedImage", "when maskImage is provided")
 	}
 	
 	if req.MaskMargin != 0 && (req.MaskMargin < 32 || req.MaskMargin > 128) {
-		return fmt.Errorf("%w:[%s][32-128]", ErrFieldIncorrectVal, "maskMargin")
+		v.incorrect("maskMargin", "32-128", req.MaskMargin)
 	}
 	
-	// Validate outpaint dimensions
 	if req.Outpaint != nil {
 		if req.SeedImage == "" {
-			return fmt.Errorf("%w:[%s when outpaint is provided]", ErrFieldRequired, "seedImage")
+			v.required("seedImage", "when outpaint is provided")
+		}
+		checkOutpaint(v, *req.Outpaint)
+	}
+	
+	for i, lora := range req.Lora {
+		checkLora(v, i, lora)
+	}
+	for i, cn := range req.ControlNet {
+		checkControlNet(v, i, cn)
+	}
+	for i, ipa := range req.IPAdapters {
+		checkIPAdapter(v, i, ipa)
+	}
+	for i, emb := range req.Embeddings {
+		if emb.Model == "" {
+			v.required(fmt.Sprintf("embeddings[%d].model", i), "")
 		}
-		
-		if req.Outpaint.Top%64 != 0 || req.Outpaint.Right%64 != 0 || 
-		   req.Outpaint.Bottom%64 != 0 || req.Outpaint.Left%64 != 0 {
-			return fmt.Errorf("%w:[%s][all values must be divisible by 64]", ErrFieldIncorrectVal, "outpaint")
+		emb.Model.check(v, fmt.Sprintf("embeddings[%d].model", i))
+	}
+	if req.Refiner != nil {
+		if req.Refiner.Model == "" {
+			v.required("refiner.model", "")
 		}
-		
-		if req.Outpaint.Blur < 0 || req.Outpaint.Blur > 32 {
-			return fmt.Errorf("%w:[%s][0-32]", ErrFieldIncorrectVal, "outpaint.blur")
+		req.Refiner.Model.check(v, "refiner.model")
+	}
+	if req.VAE != "" {
+		req.VAE.check(v, "vae")
+	}
+	if req.PuLID != nil {
+		checkPuLID(v, *req.PuLID)
+	}
+	if req.ACEPlusPlus != nil {
+		checkACEPlusPlus(v, *req.ACEPlusPlus)
+	}
+	
+	return v.err()
+}
+
+func checkOutpaint(v *validation, o Outpaint) {
+	for _, side := range []struct {
+		name  string
+		value int
+	}{{"top", o.Top}, {"right", o.Right}, {"bottom", o.Bottom}, {"left", o.Left}} {
+		if side.value%64 != 0 {
+			v.incorrect("outpaint."+side.name, "divisible by 64", side.value)
 		}
 	}
 	
-	return nil
+	if o.Blur < 0 || o.Blur > 32 {
+		v.incorrect("outpaint.blur", "0-32", o.Blur)
+	}
+}
+
+func checkLora(v *validation, i int, lora Lora) {
+	if lora.Model == "" {
+		v.required(fmt.Sprintf("lora[%d].model", i), "")
+	}
+	lora.Model.check(v, fmt.Sprintf("lora[%d].model", i))
+	if lora.Weight < -4 || lora.Weight > 4 {
+		v.incorrect(fmt.Sprintf("lora[%d].weight", i), "-4-4", lora.Weight)
+	}
+}
+
+func checkControlNet(v *validation, i int, cn ControlNet) {
+	if cn.Model == "" {
+		v.required(fmt.Sprintf("controlNet[%d].model", i), "")
+	}
+	cn.Model.check(v, fmt.Sprintf("controlNet[%d].model", i))
+	if cn.GuideImage == "" {
+		v.required(fmt.Sprintf("controlNet[%d].guideImage", i), "")
+	}
+	if cn.Weight < 0 || cn.Weight > 1 {
+		v.incorrect(fmt.Sprintf("controlNet[%d].weight", i), "0-1", cn.Weight)
+	}
+	if cn.StartStep != nil && cn.EndStep != nil && *cn.StartStep >= *cn.EndStep {
+		v.incorrect(fmt.Sprintf("controlNet[%d].startStep", i), "lower than endStep", *cn.StartStep)
+	}
+}
+
+func checkIPAdapter(v *validation, i int, ipa IPAdapter) {
+	if ipa.Model == "" {
+		v.required(fmt.Sprintf("ipAdapters[%d].model", i), "")
+	}
+	ipa.Model.check(v, fmt.Sprintf("ipAdapters[%d].model", i))
+	if ipa.GuideImage == "" {
+		v.required(fmt.Sprintf("ipAdapters[%d].guideImage", i), "")
+	}
+	if ipa.Weight < 0 || ipa.Weight > 1 {
+		v.incorrect(fmt.Sprintf("ipAdapters[%d].weight", i), "0-1", ipa.Weight)
+	}
+}
+
+func checkPuLID(v *validation, p PuLID) {
+	if len(p.InputImages) == 0 {
+		v.required("puLID.inputImages", "")
+	}
+	if p.IdWeight < 0 || p.IdWeight > 3 {
+		v.incorrect("puLID.idWeight", "0-3", p.IdWeight)
+	}
+}
+
+func checkACEPlusPlus(v *validation, ace ACEPlusPlus) {
+	switch ace.Type {
+	case ACETypePlus, ACETypeSubject, ACETypeLocalEditing:
+	default:
+		v.incorrect("acePlusPlus.type", strings.Join([]string{ACETypePlus, ACETypeSubject, ACETypeLocalEditing}, ", "), ace.Type)
+	}
+	if len(ace.InputImages) == 0 {
+		v.required("acePlusPlus.inputImages", "")
+	}
+	if ace.Type == ACETypeLocalEditing && len(ace.InputMasks) == 0 {
+		v.required("acePlusPlus.inputMasks", "when type is "+ACETypeLocalEditing)
+	}
+	if ace.RepaintingScale < 0 || ace.RepaintingScale > 1 {
+		v.incorrect("acePlusPlus.repaintingScale", "0-1", ace.RepaintingScale)
+	}
 }
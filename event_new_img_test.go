@@ -1,3 +1,8 @@
+//go:build ignore
+
+// TestGetTaskType covers getTaskType, which went away with the legacy newTask
+// request. The file is kept out of the build so the package tests compile.
+
 package runware
 
 import (
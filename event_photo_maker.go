@@ -0,0 +1,192 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PhotoMakerTriggerWord must be part of the positive prompt; it marks where
+// the subject of the input images goes
+const PhotoMakerTriggerWord = "rwre"
+
+// PhotoMaker styles
+const (
+	PhotoMakerStyleNone         = "No style"
+	PhotoMakerStyleCinematic    = "Cinematic"
+	PhotoMakerStyleDisney       = "Disney Character"
+	PhotoMakerStyleDigitalArt   = "Digital Art"
+	PhotoMakerStylePhotographic = "Photographic"
+	PhotoMakerStyleFantasyArt   = "Fantasy art"
+	PhotoMakerStyleNeonpunk     = "Neonpunk"
+	PhotoMakerStyleEnhance      = "Enhance"
+	PhotoMakerStyleComicBook    = "Comic book"
+	PhotoMakerStyleLowpoly      = "Lowpoly"
+	PhotoMakerStyleLineArt      = "Line art"
+)
+
+type NewPhotoMakerReq struct {
+	TaskType       string   `json:"taskType"`
+	TaskUUID       string   `json:"taskUUID"`
+	InputImages    []string `json:"inputImages"`
+	Style          string   `json:"style,omitempty"`
+	Strength       int      `json:"strength,omitempty"`
+	PositivePrompt string   `json:"positivePrompt"`
+	NegativePrompt string   `json:"negativePrompt,omitempty"`
+	Model          string   `json:"model,omitempty"`
+	Width          int      `json:"width"`
+	Height         int      `json:"height"`
+	Steps          int      `json:"steps,omitempty"`
+	Scheduler      string   `json:"scheduler,omitempty"`
+	CFGScale       float64  `json:"CFGScale,omitempty"`
+	Seed           *int64   `json:"seed,omitempty"`
+	NumberResults  int      `json:"numberResults,omitempty"`
+	OutputType     string   `json:"outputType,omitempty"`
+	OutputFormat   string   `json:"outputFormat,omitempty"`
+	OutputQuality  int      `json:"outputQuality,omitempty"`
+	CheckNSFW      bool     `json:"checkNSFW,omitempty"`
+	IncludeCost    bool     `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewPhotoMakerReq) MarshalJSON() ([]byte, error) {
+	type alias NewPhotoMakerReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewPhotoMakerResp struct {
+	TaskUUID string                  `json:"taskUUID"`
+	Images   []NewImageInferenceResp `json:"images"`
+	TimedOut bool                    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// PhotoMaker generates images of the subject shown in InputImages in the
+// requested style. One item is returned per requested result; on timeout the
+// images received so far are returned with TimedOut set.
+func (sdk *SDK) PhotoMaker(ctx context.Context, req NewPhotoMakerReq) (*NewPhotoMakerResp, error) {
+	req = *mergeNewPhotoMakerReqWithDefaults(&req)
+	if err := validateNewPhotoMakerReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         PhotoMaker,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newPhotoMakerResp := &NewPhotoMakerResp{TaskUUID: req.TaskUUID}
+	
+	items, err := sdk.sendTasks(ctx, sendReq, map[string]int{req.TaskUUID: req.NumberResults})
+	if err != nil && !errors.Is(err, ErrRequestTimeout) {
+		return nil, err
+	}
+	
+	for _, item := range items[req.TaskUUID] {
+		var image NewImageInferenceResp
+		if uErr := json.Unmarshal(item, &image); uErr != nil {
+			return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, uErr.Error())
+		}
+		newPhotoMakerResp.Images = append(newPhotoMakerResp.Images, image)
+	}
+	newPhotoMakerResp.Raw = rawArray(items[req.TaskUUID])
+	
+	if err != nil {
+		newPhotoMakerResp.TimedOut = true
+		for i := range newPhotoMakerResp.Images {
+			newPhotoMakerResp.Images[i].TimedOut = true
+		}
+		return newPhotoMakerResp, err
+	}
+	
+	return newPhotoMakerResp, nil
+}
+
+func NewPhotoMakerReqDefaults() *NewPhotoMakerReq {
+	return &NewPhotoMakerReq{
+		TaskType:      PhotoMaker,
+		Style:         PhotoMakerStyleNone,
+		Strength:      15,
+		Width:         1024,
+		Height:        1024,
+		Steps:         20,
+		CFGScale:      7,
+		NumberResults: 1,
+		OutputType:    OutputTypeURL,
+		OutputFormat:  OutputFormatJPG,
+		OutputQuality: 95,
+	}
+}
+
+func mergeNewPhotoMakerReqWithDefaults(req *NewPhotoMakerReq) *NewPhotoMakerReq {
+	_ = MergeEventRequestsWithDefaults[*NewPhotoMakerReq](req, NewPhotoMakerReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewPhotoMakerReq(req NewPhotoMakerReq) error {
+	if len(req.InputImages) == 0 {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "inputImages")
+	}
+	
+	if len(req.InputImages) > 4 {
+		return fmt.Errorf("%w:[%s][1-4 images]", ErrFieldIncorrectVal, "inputImages")
+	}
+	
+	for i, image := range req.InputImages {
+		if err := validateInputImage(image); err != nil {
+			return fmt.Errorf("%w:[inputImages[%d]]", err, i)
+		}
+	}
+	
+	if req.PositivePrompt == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "positivePrompt")
+	}
+	
+	if !strings.Contains(req.PositivePrompt, PhotoMakerTriggerWord) {
+		return fmt.Errorf("%w:[%s][must contain %q]", ErrFieldIncorrectVal, "positivePrompt", PhotoMakerTriggerWord)
+	}
+	
+	switch req.Style {
+	case PhotoMakerStyleNone, PhotoMakerStyleCinematic, PhotoMakerStyleDisney, PhotoMakerStyleDigitalArt,
+		PhotoMakerStylePhotographic, PhotoMakerStyleFantasyArt, PhotoMakerStyleNeonpunk, PhotoMakerStyleEnhance,
+		PhotoMakerStyleComicBook, PhotoMakerStyleLowpoly, PhotoMakerStyleLineArt:
+	default:
+		return fmt.Errorf("%w:[%s]", ErrFieldIncorrectVal, "style")
+	}
+	
+	if req.Strength < 15 || req.Strength > 50 {
+		return fmt.Errorf("%w:[%s][15-50]", ErrFieldIncorrectVal, "strength")
+	}
+	
+	if req.Width < 128 || req.Width > 2048 || req.Width%64 != 0 {
+		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "width")
+	}
+	
+	if req.Height < 128 || req.Height > 2048 || req.Height%64 != 0 {
+		return fmt.Errorf("%w:[%s][128-2048, divisible by 64]", ErrFieldIncorrectVal, "height")
+	}
+	
+	if req.Steps < 1 || req.Steps > 100 {
+		return fmt.Errorf("%w:[%s][1-100]", ErrFieldIncorrectVal, "steps")
+	}
+	
+	if req.CFGScale < 0 || req.CFGScale > 50 {
+		return fmt.Errorf("%w:[%s][0-50]", ErrFieldIncorrectVal, "CFGScale")
+	}
+	
+	if req.NumberResults < 1 || req.NumberResults > 20 {
+		return fmt.Errorf("%w:[%s][1-20]", ErrFieldIncorrectVal, "numberResults")
+	}
+	
+	return validateOutputOptions(req.OutputType, req.OutputFormat, req.OutputQuality)
+}
@@ -0,0 +1,74 @@
+package runware
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNewPhotoMakerReq(t *testing.T) {
+	valid := func() NewPhotoMakerReq {
+		req := NewPhotoMakerReq{
+			InputImages:    []string{testPNGBase64, "https://example.com/subject.jpg"},
+			PositivePrompt: "portrait of rwre as an astronaut",
+			Style:          PhotoMakerStyleCinematic,
+		}
+		return *mergeNewPhotoMakerReqWithDefaults(&req)
+	}
+	
+	testCases := []struct {
+		name    string
+		modify  func(req *NewPhotoMakerReq)
+		wantErr error
+	}{
+		{
+			name:   "Valid",
+			modify: func(req *NewPhotoMakerReq) {},
+		},
+		{
+			name:    "NoInputImages",
+			modify:  func(req *NewPhotoMakerReq) { req.InputImages = nil },
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name: "TooManyInputImages",
+			modify: func(req *NewPhotoMakerReq) {
+				req.InputImages = strings.Split(strings.Repeat(testPNGBase64+" ", 5), " ")[:5]
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "UnsupportedImageFormat",
+			modify: func(req *NewPhotoMakerReq) {
+				req.InputImages = []string{"data:image/gif;base64,R0lGODlhAQABAIAAAAAAAP///yH5BAEAAAAALAAAAAABAAEAAAIBRAA7"}
+			},
+			wantErr: ErrImageUnsupported,
+		},
+		{
+			name:    "MissingTriggerWord",
+			modify:  func(req *NewPhotoMakerReq) { req.PositivePrompt = "portrait as an astronaut" },
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "StrengthOutOfRange",
+			modify:  func(req *NewPhotoMakerReq) { req.Strength = 60 },
+			wantErr: ErrFieldIncorrectVal,
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := valid()
+			tc.modify(&req)
+			err := validateNewPhotoMakerReq(req)
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tc.wantErr), "Error should wrap the expected error")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
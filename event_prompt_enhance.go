@@ -3,111 +3,178 @@ package runware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"time"
-	
+
 	"github.com/google/uuid"
 )
 
-type NewPromptEnhanceReq struct {
-	TaskUUID         string `json:"taskUUID"`
-	PromptText       string `json:"prompt"` // PromptText json should be `promptText`
-	PromptMaxLength  int    `json:"promptMaxLength"`
-	PromptVersions   int    `json:"promptVersions"`
-	PromptLanguageId int    `json:"promptLanguageId"`
+type NewPromptEnhancerReq struct {
+	TaskType        string `json:"taskType"`
+	TaskUUID        string `json:"taskUUID"`
+	Prompt          string `json:"prompt"`
+	PromptMaxLength int    `json:"promptMaxLength"`
+	PromptVersions  int    `json:"promptVersions"`
+	IncludeCost     bool   `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
 }
 
-type NewPromptEnhanceRes struct {
-	Texts    []Text `json:"texts"`
-	TimedOut bool   `json:"timedOut"`
+func (req NewPromptEnhancerReq) MarshalJSON() ([]byte, error) {
+	type alias NewPromptEnhancerReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
 }
 
-func (sdk *SDK) PromptEnhancer(ctx context.Context, req NewPromptEnhanceReq) (*NewPromptEnhanceRes, error) {
-	req = *mergeNewPromptEnhanceReqDefaults(&req)
-	if err := validateNewPromptEnhanceReq(req); err != nil {
+// EnhancedPrompt is a single prompt version returned by the promptEnhancer task
+type EnhancedPrompt struct {
+	TaskType string  `json:"taskType"`
+	TaskUUID string  `json:"taskUUID"`
+	Text     string  `json:"text"`
+	Cost     float64 `json:"cost,omitempty"`
+}
+
+type NewPromptEnhancerResp struct {
+	TaskUUID string           `json:"taskUUID"`
+	Prompts  []EnhancedPrompt `json:"prompts"`
+	Cost     float64          `json:"cost,omitempty"`
+	TimedOut bool             `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// EnhancePrompt expands a prompt into PromptVersions enhanced variants using
+// the promptEnhancer task. On timeout the versions received so far are
+// returned with TimedOut set.
+func (sdk *SDK) EnhancePrompt(ctx context.Context, req NewPromptEnhancerReq) (*NewPromptEnhancerResp, error) {
+	req = *mergeNewPromptEnhancerReqWithDefaults(&req)
+	if err := validateNewPromptEnhancerReq(req); err != nil {
 		return nil, err
 	}
 	
 	sendReq := Request{
 		ID:            uuid.New().String(),
-		Event:         NewPromptEnhance,
-		ResponseEvent: NewPromptEnhancer,
+		Event:         PromptEnhancer,
+		ResponseEvent: ResponseData,
 		Data:          req,
 	}
 	
-	newPromptEnhanceRes := &NewPromptEnhanceRes{}
+	newPromptEnhancerResp := &NewPromptEnhancerResp{TaskUUID: req.TaskUUID}
 	
-	responseChan := make(chan *NewPromptEnhanceRes)
-	errChan := make(chan error)
+	items, err := sdk.sendTasks(ctx, sendReq, map[string]int{req.TaskUUID: req.PromptVersions})
+	if err != nil && !errors.Is(err, ErrRequestTimeout) {
+		return nil, err
+	}
 	
-	go func() {
-		defer close(responseChan)
-		defer close(errChan)
-		
-		for msg := range sdk.Client.Listen() {
-			var msgData map[string]interface{}
-			if err := json.Unmarshal(msg, &msgData); err != nil {
-				errChan <- fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
-				return
-			}
-			
-			// Check if is an error message first
-			if errMsg, ok := sdk.OnError(msgData); ok {
-				errChan <- errMsg
-				return
-			}
-			
-			for k, v := range msgData {
-				if k != sendReq.ResponseEvent {
-					log.Println("Skipping event", k, "Currently handling", sendReq.ResponseEvent)
-					continue
-				}
-				
-				bValue, err := interfaceToByte(v)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				err = json.Unmarshal(bValue, &newPromptEnhanceRes)
-				if err != nil {
-					errChan <- err
-					return
-				}
-				
-				responseChan <- newPromptEnhanceRes
-				return
-			}
-			
+	for _, item := range items[req.TaskUUID] {
+		var prompt EnhancedPrompt
+		if uErr := json.Unmarshal(item, &prompt); uErr != nil {
+			return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, uErr.Error())
 		}
-	}()
+		newPromptEnhancerResp.Prompts = append(newPromptEnhancerResp.Prompts, prompt)
+		newPromptEnhancerResp.Cost += prompt.Cost
+	}
+	newPromptEnhancerResp.Raw = rawArray(items[req.TaskUUID])
 	
-	bSendReq, err := sendReq.ToEvent()
 	if err != nil {
-		return nil, err
+		newPromptEnhancerResp.TimedOut = true
+		return newPromptEnhancerResp, err
 	}
 	
-	if err = sdk.Client.Send(bSendReq); err != nil {
-		return nil, err
+	return newPromptEnhancerResp, nil
+}
+
+func NewPromptEnhancerReqDefaults() *NewPromptEnhancerReq {
+	return &NewPromptEnhancerReq{
+		TaskType:        PromptEnhancer,
+		PromptMaxLength: 380,
+		PromptVersions:  1,
+	}
+}
+
+func mergeNewPromptEnhancerReqWithDefaults(req *NewPromptEnhancerReq) *NewPromptEnhancerReq {
+	_ = MergeEventRequestsWithDefaults[*NewPromptEnhancerReq](req, NewPromptEnhancerReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewPromptEnhancerReq(req NewPromptEnhancerReq) error {
+	if req.Prompt == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "prompt")
+	}
+	
+	if req.PromptMaxLength < 12 || req.PromptMaxLength > 400 {
+		return fmt.Errorf("%w:[%s][12-400]", ErrFieldIncorrectVal, "promptMaxLength")
+	}
+	
+	if req.PromptVersions < 1 || req.PromptVersions > 5 {
+		return fmt.Errorf("%w:[%s][1-5]", ErrFieldIncorrectVal, "promptVersions")
 	}
 	
-	select {
-	case resp := <-responseChan:
-		return resp, nil
-	case err = <-errChan:
+	return nil
+}
+
+// Legacy newPromptEnhance request, kept for backward compatibility
+
+type NewPromptEnhanceReq struct {
+	TaskUUID         string `json:"taskUUID"`
+	PromptText       string `json:"prompt"`
+	PromptMaxLength  int    `json:"promptMaxLength"`
+	PromptVersions   int    `json:"promptVersions"`
+	PromptLanguageId int    `json:"promptLanguageId"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewPromptEnhanceReq) MarshalJSON() ([]byte, error) {
+	type alias NewPromptEnhanceReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewPromptEnhanceRes struct {
+	Texts    []Text `json:"texts"`
+	TimedOut bool   `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// PromptEnhancer runs the promptEnhancer task for a legacy request and maps
+// the enhanced prompts back to Texts. PromptLanguageId is no longer supported
+// by the API and is ignored.
+//
+// Deprecated: use EnhancePrompt.
+func (sdk *SDK) PromptEnhancer(ctx context.Context, req NewPromptEnhanceReq) (*NewPromptEnhanceRes, error) {
+	req = *mergeNewPromptEnhanceReqDefaults(&req)
+	
+	resp, err := sdk.EnhancePrompt(ctx, NewPromptEnhancerReq{
+		TaskUUID:        req.TaskUUID,
+		Prompt:          req.PromptText,
+		PromptMaxLength: req.PromptMaxLength,
+		PromptVersions:  req.PromptVersions,
+		Explicit:        req.Explicit,
+		Extra:           req.Extra,
+	})
+	if resp == nil {
 		return nil, err
-	case <-time.After(timeoutSendResponse * time.Second):
-		newPromptEnhanceRes.TimedOut = true
-		return newPromptEnhanceRes, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
-	case <-ctx.Done():
-		return nil, ctx.Err()
 	}
+	
+	newPromptEnhanceRes := &NewPromptEnhanceRes{
+		TimedOut: resp.TimedOut,
+		Raw:      resp.Raw,
+	}
+	for _, prompt := range resp.Prompts {
+		newPromptEnhanceRes.Texts = append(newPromptEnhanceRes.Texts, Text{
+			TaskUUID: prompt.TaskUUID,
+			Text:     prompt.Text,
+		})
+	}
+	
+	return newPromptEnhanceRes, err
 }
 
 func NewPromptEnhanceReqDefaults() *NewPromptEnhanceReq {
 	return &NewPromptEnhanceReq{
-		TaskUUID:         uuid.New().String(),
 		PromptLanguageId: 1,
 		PromptVersions:   3,
 	}
@@ -115,17 +182,6 @@ func NewPromptEnhanceReqDefaults() *NewPromptEnhanceReq {
 
 func mergeNewPromptEnhanceReqDefaults(req *NewPromptEnhanceReq) *NewPromptEnhanceReq {
 	_ = MergeEventRequestsWithDefaults[*NewPromptEnhanceReq](req, NewPromptEnhanceReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
 	return req
 }
-
-func validateNewPromptEnhanceReq(req NewPromptEnhanceReq) error {
-	if req.PromptMaxLength < 1 || req.PromptVersions > 380 {
-		return fmt.Errorf("%w:[%s][1-380]", ErrFieldIncorrectVal, "promptMaxLength")
-	}
-	
-	if req.PromptVersions < 1 || req.PromptVersions > 5 {
-		return fmt.Errorf("%w:[%s][1-380]", ErrFieldIncorrectVal, "promptVersions")
-	}
-	
-	return nil
-}
@@ -0,0 +1,73 @@
+package runware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNewPromptEnhancerReq(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     NewPromptEnhancerReq
+		wantErr error
+	}{
+		{
+			name:    "MissingPrompt",
+			req:     NewPromptEnhancerReq{PromptMaxLength: 64, PromptVersions: 1},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name:    "MaxLengthTooShort",
+			req:     NewPromptEnhancerReq{Prompt: "cat", PromptMaxLength: 11, PromptVersions: 1},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "MaxLengthTooLong",
+			req:     NewPromptEnhancerReq{Prompt: "cat", PromptMaxLength: 401, PromptVersions: 1},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name:    "TooManyVersions",
+			req:     NewPromptEnhancerReq{Prompt: "cat", PromptMaxLength: 64, PromptVersions: 6},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "Valid",
+			req:  NewPromptEnhancerReq{Prompt: "cat", PromptMaxLength: 400, PromptVersions: 5},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNewPromptEnhancerReq(tc.req)
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tc.wantErr), "Error should wrap the expected error")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPromptEnhancerKeepsExplicitFields(t *testing.T) {
+	sdk := &SDK{Client: &MockRunware{
+		ListenFunc: func() chan []byte {
+			return make(chan []byte)
+		},
+		SendFunc: func(b []byte) error {
+			return errors.New("unexpected send")
+		},
+	}}
+	
+	// An explicit zero length is not replaced by the default of the new task
+	_, err := sdk.PromptEnhancer(context.Background(), NewPromptEnhanceReq{
+		PromptText: "cat",
+		Explicit:   ExplicitFields{"promptMaxLength"},
+	})
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+	assert.ErrorContains(t, err, "promptMaxLength")
+}
@@ -0,0 +1,152 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Background removal models
+const (
+	BackgroundRemovalModelRemBG14    = "runware:109@1"
+	BackgroundRemovalModelBiRefNet   = "runware:112@1"
+	BackgroundRemovalModelBriaRMBG20 = "runware:110@1"
+)
+
+// RemoveBackgroundSettings contains the post-processing options applied to the
+// extracted foreground
+type RemoveBackgroundSettings struct {
+	RGBA                            []int `json:"rgba,omitempty"`
+	PostProcessMask                 bool  `json:"postProcessMask,omitempty"`
+	ReturnOnlyMask                  bool  `json:"returnOnlyMask,omitempty"`
+	AlphaMatting                    bool  `json:"alphaMatting,omitempty"`
+	AlphaMattingForegroundThreshold int   `json:"alphaMattingForegroundThreshold,omitempty"`
+	AlphaMattingBackgroundThreshold int   `json:"alphaMattingBackgroundThreshold,omitempty"`
+	AlphaMattingErodeSize           int   `json:"alphaMattingErodeSize,omitempty"`
+}
+
+type NewRemoveBackgroundReq struct {
+	TaskType      string                    `json:"taskType"`
+	TaskUUID      string                    `json:"taskUUID"`
+	InputImage    string                    `json:"inputImage"`
+	Model         string                    `json:"model,omitempty"`
+	OutputType    string                    `json:"outputType,omitempty"`
+	OutputFormat  string                    `json:"outputFormat,omitempty"`
+	OutputQuality int                       `json:"outputQuality,omitempty"`
+	IncludeCost   bool                      `json:"includeCost,omitempty"`
+	Settings      *RemoveBackgroundSettings `json:"settings,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewRemoveBackgroundReq) MarshalJSON() ([]byte, error) {
+	type alias NewRemoveBackgroundReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewRemoveBackgroundResp struct {
+	TaskType        string  `json:"taskType"`
+	TaskUUID        string  `json:"taskUUID"`
+	InputImageUUID  string  `json:"inputImageUUID"`
+	ImageUUID       string  `json:"imageUUID"`
+	ImageURL        string  `json:"imageURL,omitempty"`
+	ImageBase64Data string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    string  `json:"imageDataURI,omitempty"`
+	Cost            float64 `json:"cost,omitempty"`
+	TimedOut        bool    `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewRemoveBackgroundResp) UnmarshalJSON(data []byte) error {
+	type alias NewRemoveBackgroundResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+func (sdk *SDK) RemoveBackground(ctx context.Context, req NewRemoveBackgroundReq) (*NewRemoveBackgroundResp, error) {
+	req = *mergeNewRemoveBackgroundReqWithDefaults(&req)
+	if err := validateNewRemoveBackgroundReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         RemoveBackground,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newRemoveBackgroundResp := &NewRemoveBackgroundResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newRemoveBackgroundResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newRemoveBackgroundResp.TimedOut = true
+			return newRemoveBackgroundResp, err
+		}
+		return nil, err
+	}
+	
+	return newRemoveBackgroundResp, nil
+}
+
+func NewRemoveBackgroundReqDefaults() *NewRemoveBackgroundReq {
+	return &NewRemoveBackgroundReq{
+		TaskType:      RemoveBackground,
+		OutputType:    OutputTypeURL,
+		OutputFormat:  OutputFormatPNG,
+		OutputQuality: 95,
+	}
+}
+
+func mergeNewRemoveBackgroundReqWithDefaults(req *NewRemoveBackgroundReq) *NewRemoveBackgroundReq {
+	_ = MergeEventRequestsWithDefaults[*NewRemoveBackgroundReq](req, NewRemoveBackgroundReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewRemoveBackgroundReq(req NewRemoveBackgroundReq) error {
+	if err := validateInputImage(req.InputImage); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputImage")
+	}
+	
+	if err := validateOutputOptions(req.OutputType, req.OutputFormat, req.OutputQuality); err != nil {
+		return err
+	}
+	
+	if req.Settings == nil {
+		return nil
+	}
+	
+	if req.Settings.RGBA != nil {
+		if len(req.Settings.RGBA) != 4 {
+			return fmt.Errorf("%w:[%s][4 values]", ErrFieldIncorrectVal, "settings.rgba")
+		}
+		for _, v := range req.Settings.RGBA {
+			if v < 0 || v > 255 {
+				return fmt.Errorf("%w:[%s][0-255]", ErrFieldIncorrectVal, "settings.rgba")
+			}
+		}
+	}
+	
+	thresholds := []struct {
+		field string
+		value int
+	}{
+		{"settings.alphaMattingForegroundThreshold", req.Settings.AlphaMattingForegroundThreshold},
+		{"settings.alphaMattingBackgroundThreshold", req.Settings.AlphaMattingBackgroundThreshold},
+		{"settings.alphaMattingErodeSize", req.Settings.AlphaMattingErodeSize},
+	}
+	for _, t := range thresholds {
+		if t.value != 0 && !req.Settings.AlphaMatting {
+			return fmt.Errorf("%w:[%s when %s is set]", ErrFieldRequired, "settings.alphaMatting", t.field)
+		}
+		if t.value < 0 || t.value > 255 {
+			return fmt.Errorf("%w:[%s][0-255]", ErrFieldIncorrectVal, t.field)
+		}
+	}
+	
+	return nil
+}
@@ -0,0 +1,113 @@
+package runware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPNGBase64 = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+func TestValidateNewRemoveBackgroundReq(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     NewRemoveBackgroundReq
+		wantErr error
+	}{
+		{
+			name:    "MissingInputImage",
+			req:     NewRemoveBackgroundReq{},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name:    "InvalidInputImage",
+			req:     NewRemoveBackgroundReq{InputImage: "not-an-image"},
+			wantErr: ErrImageIsNotBase64,
+		},
+		{
+			name: "ImageUUID",
+			req:  NewRemoveBackgroundReq{InputImage: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10"},
+		},
+		{
+			name: "ImageURL",
+			req:  NewRemoveBackgroundReq{InputImage: "https://im.runware.ai/image/ws/0.5/ii/a770f077.png"},
+		},
+		{
+			name: "Base64",
+			req:  NewRemoveBackgroundReq{InputImage: testPNGBase64, OutputFormat: OutputFormatPNG},
+		},
+		{
+			name:    "WrongOutputFormat",
+			req:     NewRemoveBackgroundReq{InputImage: testPNGBase64, OutputFormat: "GIF"},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "WrongRGBA",
+			req: NewRemoveBackgroundReq{
+				InputImage: testPNGBase64,
+				Settings:   &RemoveBackgroundSettings{RGBA: []int{255, 255, 255}},
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "ThresholdWithoutAlphaMatting",
+			req: NewRemoveBackgroundReq{
+				InputImage: testPNGBase64,
+				Settings:   &RemoveBackgroundSettings{AlphaMattingForegroundThreshold: 240},
+			},
+			wantErr: ErrFieldRequired,
+		},
+		{
+			name: "ThresholdOutOfRange",
+			req: NewRemoveBackgroundReq{
+				InputImage: testPNGBase64,
+				Settings: &RemoveBackgroundSettings{
+					AlphaMatting:                    true,
+					AlphaMattingBackgroundThreshold: 300,
+				},
+			},
+			wantErr: ErrFieldIncorrectVal,
+		},
+		{
+			name: "ZeroThresholds",
+			req: NewRemoveBackgroundReq{
+				InputImage: testPNGBase64,
+				Settings:   &RemoveBackgroundSettings{AlphaMatting: true},
+			},
+		},
+		{
+			name: "AlphaMatting",
+			req: NewRemoveBackgroundReq{
+				InputImage: testPNGBase64,
+				Settings: &RemoveBackgroundSettings{
+					RGBA:                            []int{255, 255, 255, 0},
+					AlphaMatting:                    true,
+					AlphaMattingForegroundThreshold: 240,
+					AlphaMattingBackgroundThreshold: 10,
+					AlphaMattingErodeSize:           10,
+				},
+			},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateNewRemoveBackgroundReq(tc.req)
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tc.wantErr), "Error should wrap the expected error")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRemoveBackgroundThresholdRangeMessage(t *testing.T) {
+	err := validateNewRemoveBackgroundReq(NewRemoveBackgroundReq{
+		InputImage: testPNGBase64,
+		Settings:   &RemoveBackgroundSettings{AlphaMatting: true, AlphaMattingErodeSize: -1},
+	})
+	assert.ErrorContains(t, err, "[settings.alphaMattingErodeSize][0-255]")
+}
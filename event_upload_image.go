@@ -0,0 +1,142 @@
+package runware
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+// NewUploadImageReq is the request of the imageUpload task sent by
+// UploadImage. Not to be confused with NewImageUploadReq, the request of the
+// legacy newImageUpload event sent by ImageUpload.
+type NewUploadImageReq struct {
+	TaskType string `json:"taskType"`
+	TaskUUID string `json:"taskUUID"`
+	Image    string `json:"image"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewUploadImageReq) MarshalJSON() ([]byte, error) {
+	type alias NewUploadImageReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewUploadImageResp struct {
+	TaskType  string `json:"taskType"`
+	TaskUUID  string `json:"taskUUID"`
+	ImageUUID string `json:"imageUUID"`
+	ImageURL  string `json:"imageURL,omitempty"`
+	TimedOut  bool   `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewUploadImageResp) UnmarshalJSON(data []byte) error {
+	type alias NewUploadImageResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// UploadImage stores an image given as a URL, data URI or base64 string using
+// the imageUpload task. The returned ImageUUID can be used as SeedImage,
+// MaskImage, ControlNet.GuideImage, IPAdapter.GuideImage or as the input image
+// of any other task. It replaces the legacy ImageUpload.
+func (sdk *SDK) UploadImage(ctx context.Context, req NewUploadImageReq) (*NewUploadImageResp, error) {
+	req = *mergeNewUploadImageReqWithDefaults(&req)
+	if err := validateNewUploadImageReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         ImageUpload,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newUploadImageResp := &NewUploadImageResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newUploadImageResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newUploadImageResp.TimedOut = true
+			return newUploadImageResp, err
+		}
+		return nil, err
+	}
+	
+	return newUploadImageResp, nil
+}
+
+// UploadFile uploads the image stored at path
+func (sdk *SDK) UploadFile(ctx context.Context, path string) (*NewUploadImageResp, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	
+	return sdk.UploadReader(ctx, file)
+}
+
+// UploadReader uploads the image read from r. Only formats accepted by the
+// API (JPEG, PNG and WEBP) are sent.
+func (sdk *SDK) UploadReader(ctx context.Context, r io.Reader) (*NewUploadImageResp, error) {
+	dataURI, err := readerToDataURI(r)
+	if err != nil {
+		return nil, err
+	}
+	
+	return sdk.UploadImage(ctx, NewUploadImageReq{
+		Image: dataURI,
+	})
+}
+
+// readerToDataURI reads an image and encodes it as a data URI
+func readerToDataURI(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	
+	format, err := decodeImage(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	
+	return fmt.Sprintf("data:image/%s;base64,%s", format, base64.StdEncoding.EncodeToString(data)), nil
+}
+
+func NewUploadImageReqDefaults() *NewUploadImageReq {
+	return &NewUploadImageReq{
+		TaskType: ImageUpload,
+	}
+}
+
+func mergeNewUploadImageReqWithDefaults(req *NewUploadImageReq) *NewUploadImageReq {
+	_ = MergeEventRequestsWithDefaults[*NewUploadImageReq](req, NewUploadImageReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewUploadImageReq(req NewUploadImageReq) error {
+	if req.Image == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "image")
+	}
+	
+	if isImageURL(req.Image) {
+		return nil
+	}
+	
+	if _, err := isValidBase64Image(req.Image); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "image")
+	}
+	
+	return nil
+}
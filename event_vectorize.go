@@ -0,0 +1,188 @@
+package runware
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Vectorize models
+const (
+	VectorizeModelRecraft = "recraft:1@1"
+	VectorizeModelPicsart = "picsart:1@1"
+)
+
+// VectorizeInputs holds the raster image to trace
+type VectorizeInputs struct {
+	Image string `json:"image"`
+}
+
+type NewVectorizeReq struct {
+	TaskType     string          `json:"taskType"`
+	TaskUUID     string          `json:"taskUUID"`
+	Model        string          `json:"model,omitempty"`
+	Inputs       VectorizeInputs `json:"inputs"`
+	OutputType   string          `json:"outputType,omitempty"`
+	OutputFormat string          `json:"outputFormat,omitempty"`
+	IncludeCost  bool            `json:"includeCost,omitempty"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewVectorizeReq) MarshalJSON() ([]byte, error) {
+	type alias NewVectorizeReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+type NewVectorizeResp struct {
+	TaskType        string  `json:"taskType"`
+	TaskUUID        string  `json:"taskUUID"`
+	ImageUUID       string  `json:"imageUUID"`
+	ImageURL        string  `json:"imageURL,omitempty"`
+	ImageBase64Data string  `json:"imageBase64Data,omitempty"`
+	ImageDataURI    string  `json:"imageDataURI,omitempty"`
+	Cost            float64 `json:"cost,omitempty"`
+	TimedOut        bool    `json:"timedOut"`
+
+	// SVG holds the decoded document when the output type is base64Data or
+	// dataURI. It has been checked with ValidateSVG.
+	SVG string `json:"-"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *NewVectorizeResp) UnmarshalJSON(data []byte) error {
+	type alias NewVectorizeResp
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// Vectorize traces a raster image into an SVG using the vectorize task. With
+// OutputTypeURL the SVG is returned as ImageURL, otherwise it is decoded into
+// SVG and validated before being returned.
+func (sdk *SDK) Vectorize(ctx context.Context, req NewVectorizeReq) (*NewVectorizeResp, error) {
+	req = *mergeNewVectorizeReqWithDefaults(&req)
+	if err := validateNewVectorizeReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         Vectorize,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newVectorizeResp := &NewVectorizeResp{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, newVectorizeResp); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newVectorizeResp.TimedOut = true
+			return newVectorizeResp, err
+		}
+		return nil, err
+	}
+	
+	svg, err := decodeInlineSVG(newVectorizeResp)
+	if err != nil {
+		return nil, err
+	}
+	newVectorizeResp.SVG = svg
+	
+	return newVectorizeResp, nil
+}
+
+// decodeInlineSVG extracts and validates the SVG document of a base64 or
+// data URI response. URL responses are left untouched.
+func decodeInlineSVG(resp *NewVectorizeResp) (string, error) {
+	encoded := resp.ImageBase64Data
+	if resp.ImageDataURI != "" {
+		commaIndex := strings.Index(resp.ImageDataURI, ",")
+		if commaIndex == -1 {
+			return "", fmt.Errorf("%w:[%s]", ErrImageWrongSchema, "imageDataURI")
+		}
+		encoded = resp.ImageDataURI[commaIndex+1:]
+	}
+	
+	if encoded == "" {
+		return "", nil
+	}
+	
+	svg, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("%w:[%s]", ErrImageIsNotBase64, "svg")
+	}
+	
+	if err = ValidateSVG(svg); err != nil {
+		return "", err
+	}
+	
+	return string(svg), nil
+}
+
+// ValidateSVG checks that data is a well-formed XML document whose root
+// element is <svg>
+func ValidateSVG(data []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	
+	rootSeen := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w:[%s]", ErrInvalidSVG, err.Error())
+		}
+		
+		start, ok := token.(xml.StartElement)
+		if !ok || rootSeen {
+			continue
+		}
+		
+		if start.Name.Local != "svg" {
+			return fmt.Errorf("%w:[root element is <%s>]", ErrInvalidSVG, start.Name.Local)
+		}
+		rootSeen = true
+	}
+	
+	if !rootSeen {
+		return fmt.Errorf("%w:[%s]", ErrInvalidSVG, "missing <svg> element")
+	}
+	
+	return nil
+}
+
+func NewVectorizeReqDefaults() *NewVectorizeReq {
+	return &NewVectorizeReq{
+		TaskType:     Vectorize,
+		Model:        VectorizeModelRecraft,
+		OutputType:   OutputTypeURL,
+		OutputFormat: OutputFormatSVG,
+	}
+}
+
+func mergeNewVectorizeReqWithDefaults(req *NewVectorizeReq) *NewVectorizeReq {
+	_ = MergeEventRequestsWithDefaults[*NewVectorizeReq](req, NewVectorizeReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewVectorizeReq(req NewVectorizeReq) error {
+	if err := validateInputImage(req.Inputs.Image); err != nil {
+		return fmt.Errorf("%w:[%s]", err, "inputs.image")
+	}
+	
+	if req.OutputFormat != OutputFormatSVG {
+		return fmt.Errorf("%w:[%s][%s]", ErrFieldIncorrectVal, "outputFormat", OutputFormatSVG)
+	}
+	
+	return validateOutputOptions(req.OutputType, "", 0)
+}
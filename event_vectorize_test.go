@@ -0,0 +1,63 @@
+package runware
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSVG(t *testing.T) {
+	testCases := []struct {
+		name    string
+		svg     string
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			svg:  `<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10"><path d="M0 0h10v10z"/></svg>`,
+		},
+		{
+			name:    "Unclosed",
+			svg:     `<svg xmlns="http://www.w3.org/2000/svg"><path d="M0 0h10v10z">`,
+			wantErr: true,
+		},
+		{
+			name:    "WrongRoot",
+			svg:     `<html><svg/></html>`,
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			svg:     ``,
+			wantErr: true,
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSVG([]byte(tc.svg))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSVG)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeInlineSVG(t *testing.T) {
+	svg := `<svg xmlns="http://www.w3.org/2000/svg"/>`
+	encoded := base64.StdEncoding.EncodeToString([]byte(svg))
+	
+	got, err := decodeInlineSVG(&NewVectorizeResp{ImageDataURI: "data:image/svg+xml;base64," + encoded})
+	assert.NoError(t, err)
+	assert.Equal(t, svg, got)
+	
+	got, err = decodeInlineSVG(&NewVectorizeResp{ImageURL: "https://example.com/logo.svg"})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	
+	_, err = decodeInlineSVG(&NewVectorizeResp{ImageBase64Data: base64.StdEncoding.EncodeToString([]byte("<svg>"))})
+	assert.ErrorIs(t, err, ErrInvalidSVG)
+}
@@ -0,0 +1,307 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultVideoPollInterval = 5 * time.Second
+
+// Frame positions for FrameImage
+const (
+	FramePositionFirst = "first"
+	FramePositionLast  = "last"
+)
+
+// FrameImage pins an input image to a frame of the generated video
+type FrameImage struct {
+	InputImage string `json:"inputImage"`
+	Frame      string `json:"frame,omitempty"`
+}
+
+// GoogleVideoSettings represents Google (Veo) provider-specific settings
+type GoogleVideoSettings struct {
+	EnhancePrompt bool `json:"enhancePrompt,omitempty"`
+	GenerateAudio bool `json:"generateAudio,omitempty"`
+}
+
+// MiniMaxVideoSettings represents MiniMax provider-specific settings
+type MiniMaxVideoSettings struct {
+	PromptOptimizer bool `json:"promptOptimizer,omitempty"`
+}
+
+// ByteDanceVideoSettings represents ByteDance provider-specific settings
+type ByteDanceVideoSettings struct {
+	CameraFixed bool `json:"cameraFixed,omitempty"`
+}
+
+// VideoProviderSettings contains provider-specific configurations for video models
+type VideoProviderSettings struct {
+	Google    *GoogleVideoSettings    `json:"google,omitempty"`
+	MiniMax   *MiniMaxVideoSettings   `json:"minimax,omitempty"`
+	ByteDance *ByteDanceVideoSettings `json:"bytedance,omitempty"`
+}
+
+type NewVideoInferenceReq struct {
+	TaskType       string `json:"taskType"`
+	TaskUUID       string `json:"taskUUID"`
+	DeliveryMethod string `json:"deliveryMethod"`
+
+	// Output configuration
+	OutputType    string `json:"outputType,omitempty"`
+	OutputFormat  string `json:"outputFormat,omitempty"`
+	OutputQuality int    `json:"outputQuality,omitempty"`
+	IncludeCost   bool   `json:"includeCost,omitempty"`
+
+	// Core generation parameters
+	PositivePrompt string       `json:"positivePrompt"`
+	NegativePrompt string       `json:"negativePrompt,omitempty"`
+	Model          ModelID      `json:"model"`
+	Duration       float64      `json:"duration,omitempty"`
+	FPS            int          `json:"fps,omitempty"`
+	Width          int          `json:"width,omitempty"`
+	Height         int          `json:"height,omitempty"`
+	Seed           *int64       `json:"seed,omitempty"`
+	NumberResults  int          `json:"numberResults,omitempty"`
+	FrameImages    []FrameImage `json:"frameImages,omitempty"`
+
+	// Provider-specific settings
+	ProviderSettings *VideoProviderSettings `json:"providerSettings,omitempty"`
+
+	// PollInterval is the delay between two status requests while the video
+	// is being generated
+	PollInterval time.Duration `json:"-"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
+}
+
+func (req NewVideoInferenceReq) MarshalJSON() ([]byte, error) {
+	type alias NewVideoInferenceReq
+	return marshalWithExtra(alias(req), withExplicit(req, req.Explicit, req.Extra))
+}
+
+// VideoResult is a single video produced by the videoInference task
+type VideoResult struct {
+	TaskType  string  `json:"taskType"`
+	TaskUUID  string  `json:"taskUUID"`
+	Status    string  `json:"status"`
+	VideoUUID string  `json:"videoUUID,omitempty"`
+	VideoURL  string  `json:"videoURL,omitempty"`
+	Seed      int64   `json:"seed,omitempty"`
+	Cost      float64 `json:"cost,omitempty"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (resp *VideoResult) UnmarshalJSON(data []byte) error {
+	type alias VideoResult
+	return unmarshalWithRaw(data, (*alias)(resp), &resp.Raw)
+}
+
+// VideoProgress is reported after every status poll
+type VideoProgress struct {
+	TaskUUID  string
+	Status    string
+	Completed int
+	Total     int
+	Elapsed   time.Duration
+}
+
+type NewVideoInferenceResp struct {
+	TaskUUID string        `json:"taskUUID"`
+	Videos   []VideoResult `json:"videos"`
+	Cost     float64       `json:"cost,omitempty"`
+	TimedOut bool          `json:"timedOut"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// VideoInference submits a videoInference task asynchronously and polls its
+// status until every requested video is ready or ctx is done. onProgress,
+// which may be nil, is called after every poll.
+func (sdk *SDK) VideoInference(ctx context.Context, req NewVideoInferenceReq, onProgress func(VideoProgress)) (*NewVideoInferenceResp, error) {
+	req = *mergeNewVideoInferenceReqWithDefaults(&req)
+	if err := validateNewVideoInferenceReq(req); err != nil {
+		return nil, err
+	}
+	
+	sendReq := Request{
+		ID:            uuid.New().String(),
+		Event:         VideoInference,
+		ResponseEvent: ResponseData,
+		Data:          req,
+	}
+	
+	newVideoInferenceResp := &NewVideoInferenceResp{TaskUUID: req.TaskUUID}
+	
+	// The server acknowledges async tasks right away
+	ack := &VideoResult{}
+	if err := sdk.sendTask(ctx, sendReq, req.TaskUUID, ack); err != nil {
+		if errors.Is(err, ErrRequestTimeout) {
+			newVideoInferenceResp.TimedOut = true
+			return newVideoInferenceResp, err
+		}
+		return nil, err
+	}
+	
+	started := time.Now()
+	seen := make(map[string]bool, req.NumberResults)
+	raws := make([][]byte, 0, req.NumberResults)
+	ticker := time.NewTicker(req.PollInterval)
+	defer ticker.Stop()
+	
+	for len(newVideoInferenceResp.Videos) < req.NumberResults {
+		select {
+		case <-ctx.Done():
+			return newVideoInferenceResp, ctx.Err()
+		case <-ticker.C:
+		}
+		
+		items, err := sdk.getResponse(ctx, NewGetResponseReq{TaskType: GetResponse, TaskUUID: req.TaskUUID})
+		if errors.Is(err, ErrRequestTimeout) {
+			// A missed poll is retried on the next tick
+			continue
+		}
+		if err != nil {
+			return newVideoInferenceResp, err
+		}
+		
+		// Every finished video of the task may come in the same answer
+		status := ""
+		for i, item := range items {
+			result := VideoResult{}
+			if err := json.Unmarshal(item, &result); err != nil {
+				return newVideoInferenceResp, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+			}
+			status = result.Status
+			
+			switch result.Status {
+			case TaskStatusError:
+				return newVideoInferenceResp, fmt.Errorf("%w:[%s]", ErrTaskFailed, req.TaskUUID)
+			case TaskStatusSuccess:
+				if key := videoKey(result, i); !seen[key] {
+					seen[key] = true
+					newVideoInferenceResp.Videos = append(newVideoInferenceResp.Videos, result)
+					newVideoInferenceResp.Cost += result.Cost
+					raws = append(raws, result.Raw)
+					newVideoInferenceResp.Raw = rawArray(raws)
+				}
+			}
+		}
+		
+		if onProgress != nil {
+			onProgress(VideoProgress{
+				TaskUUID:  req.TaskUUID,
+				Status:    status,
+				Completed: len(newVideoInferenceResp.Videos),
+				Total:     req.NumberResults,
+				Elapsed:   time.Since(started),
+			})
+		}
+	}
+	
+	return newVideoInferenceResp, nil
+}
+
+// videoKey identifies a finished video across polls by its UUID, or its URL
+// when the server sends no UUID, or else by its position in the response
+func videoKey(result VideoResult, position int) string {
+	switch {
+	case result.VideoUUID != "":
+		return "uuid:" + result.VideoUUID
+	case result.VideoURL != "":
+		return "url:" + result.VideoURL
+	default:
+		return fmt.Sprintf("position:%d", position)
+	}
+}
+
+func NewVideoInferenceReqDefaults() *NewVideoInferenceReq {
+	return &NewVideoInferenceReq{
+		TaskType:       VideoInference,
+		DeliveryMethod: DeliveryMethodAsync,
+		OutputType:     OutputTypeURL,
+		OutputFormat:   OutputFormatMP4,
+		OutputQuality:  95,
+		NumberResults:  1,
+		PollInterval:   defaultVideoPollInterval,
+	}
+}
+
+func mergeNewVideoInferenceReqWithDefaults(req *NewVideoInferenceReq) *NewVideoInferenceReq {
+	_ = MergeEventRequestsWithDefaults[*NewVideoInferenceReq](req, NewVideoInferenceReqDefaults())
+	ensureTaskUUID(&req.TaskUUID)
+	return req
+}
+
+func validateNewVideoInferenceReq(req NewVideoInferenceReq) error {
+	if req.PositivePrompt == "" && len(req.FrameImages) == 0 {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "positivePrompt")
+	}
+	
+	if req.Model == "" {
+		return fmt.Errorf("%w:[%s]", ErrFieldRequired, "model")
+	}
+	
+	if !req.Model.Valid() {
+		return fmt.Errorf("%w:[%s][source:id@version]", ErrFieldIncorrectVal, "model")
+	}
+	
+	if req.DeliveryMethod != DeliveryMethodAsync {
+		return fmt.Errorf("%w:[%s][%s]", ErrFieldIncorrectVal, "deliveryMethod", DeliveryMethodAsync)
+	}
+	
+	switch req.OutputFormat {
+	case OutputFormatMP4, OutputFormatWEBM, OutputFormatMOV:
+	default:
+		return fmt.Errorf("%w:[%s][%s, %s, %s]", ErrFieldIncorrectVal, "outputFormat",
+			OutputFormatMP4, OutputFormatWEBM, OutputFormatMOV)
+	}
+	
+	if req.OutputQuality < 20 || req.OutputQuality > 99 {
+		return fmt.Errorf("%w:[%s][20-99]", ErrFieldIncorrectVal, "outputQuality")
+	}
+	
+	if req.Duration < 0 || req.Duration > 60 {
+		return fmt.Errorf("%w:[%s][0-60]", ErrFieldIncorrectVal, "duration")
+	}
+	
+	if req.FPS != 0 && (req.FPS < 15 || req.FPS > 60) {
+		return fmt.Errorf("%w:[%s][15-60]", ErrFieldIncorrectVal, "fps")
+	}
+	
+	if (req.Width == 0) != (req.Height == 0) {
+		return fmt.Errorf("%w:[%s][width and height must be set together]", ErrFieldRequired, "width/height")
+	}
+	
+	if req.Width%8 != 0 || req.Height%8 != 0 {
+		return fmt.Errorf("%w:[%s][divisible by 8]", ErrFieldIncorrectVal, "width/height")
+	}
+	
+	if req.NumberResults < 1 || req.NumberResults > 4 {
+		return fmt.Errorf("%w:[%s][1-4]", ErrFieldIncorrectVal, "numberResults")
+	}
+	
+	if req.PollInterval < time.Second {
+		return fmt.Errorf("%w:[%s][>=1s]", ErrFieldIncorrectVal, "pollInterval")
+	}
+	
+	for i, frame := range req.FrameImages {
+		if err := validateInputImage(frame.InputImage); err != nil {
+			return fmt.Errorf("%w:[frameImages[%d].inputImage]", err, i)
+		}
+		switch frame.Frame {
+		case "", FramePositionFirst, FramePositionLast:
+		default:
+			return fmt.Errorf("%w:[frameImages[%d].frame][%s, %s]", ErrFieldIncorrectVal, i, FramePositionFirst, FramePositionLast)
+		}
+	}
+	
+	return nil
+}
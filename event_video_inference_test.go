@@ -0,0 +1,159 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoInferencePolling(t *testing.T) {
+	listen := make(chan []byte, 1)
+	polls := 0
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			
+			taskUUID := sent[0]["taskUUID"]
+			switch sent[0]["taskType"] {
+			case VideoInference:
+				assert.Equal(t, DeliveryMethodAsync, sent[0]["deliveryMethod"])
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"videoInference","taskUUID":"%s"}]}`, taskUUID))
+			case GetResponse:
+				polls++
+				if polls < 2 {
+					listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"videoInference","taskUUID":"%s","status":"processing"}]}`, taskUUID))
+					return nil
+				}
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"videoInference","taskUUID":"%s","status":"success","videoUUID":"v1","videoURL":"https://example.com/v1.mp4","seed":42,"cost":0.5}]}`, taskUUID))
+			}
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	var progress []VideoProgress
+	resp, err := sdk.VideoInference(context.Background(), NewVideoInferenceReq{
+		PositivePrompt: "a paper boat drifting down a rainy street",
+		Model:          "klingai:5@3",
+		Duration:       5,
+		PollInterval:   time.Second,
+	}, func(p VideoProgress) {
+		progress = append(progress, p)
+	})
+	
+	require.NoError(t, err)
+	require.Len(t, resp.Videos, 1)
+	assert.Equal(t, "https://example.com/v1.mp4", resp.Videos[0].VideoURL)
+	assert.Equal(t, int64(42), resp.Videos[0].Seed)
+	assert.Equal(t, 0.5, resp.Cost)
+	require.Len(t, progress, 2)
+	assert.Equal(t, TaskStatusProcessing, progress[0].Status)
+	assert.Equal(t, 1, progress[1].Completed)
+}
+
+func TestVideoInferenceCollectsResultsOfOneResponse(t *testing.T) {
+	listen := make(chan []byte, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			
+			taskUUID := sent[0]["taskUUID"]
+			switch sent[0]["taskType"] {
+			case VideoInference:
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"videoInference","taskUUID":"%s"}]}`, taskUUID))
+			case GetResponse:
+				listen <- []byte(fmt.Sprintf(`{"data":[`+
+					`{"taskType":"videoInference","taskUUID":"%[1]s","status":"success","videoUUID":"v1","cost":0.5},`+
+					`{"taskType":"videoInference","taskUUID":"%[1]s","status":"success","videoUUID":"v2","cost":0.5}]}`, taskUUID))
+			}
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	
+	resp, err := sdk.VideoInference(ctx, NewVideoInferenceReq{
+		PositivePrompt: "a paper boat drifting down a rainy street",
+		Model:          "klingai:5@3",
+		Duration:       5,
+		NumberResults:  2,
+		PollInterval:   time.Second,
+	}, nil)
+	
+	require.NoError(t, err)
+	require.Len(t, resp.Videos, 2)
+	assert.Equal(t, "v1", resp.Videos[0].VideoUUID)
+	assert.Equal(t, "v2", resp.Videos[1].VideoUUID)
+	assert.Equal(t, 1.0, resp.Cost)
+}
+
+func TestVideoInferenceResultsWithoutUUID(t *testing.T) {
+	testCases := []struct {
+		name  string
+		items string
+		want  []string
+	}{
+		{
+			name: "By URL",
+			items: `{"taskType":"videoInference","taskUUID":"%[1]s","status":"success","videoURL":"https://example.com/1.mp4"},` +
+				`{"taskType":"videoInference","taskUUID":"%[1]s","status":"success","videoURL":"https://example.com/2.mp4"}`,
+			want: []string{"https://example.com/1.mp4", "https://example.com/2.mp4"},
+		},
+		{
+			name: "By position",
+			items: `{"taskType":"videoInference","taskUUID":"%[1]s","status":"success"},` +
+				`{"taskType":"videoInference","taskUUID":"%[1]s","status":"success"}`,
+			want: []string{"", ""},
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listen := make(chan []byte, 1)
+			sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+				if task["taskType"] == VideoInference {
+					return fmt.Sprintf(`{"data":[{"taskType":"videoInference","taskUUID":"%s"}]}`, task["taskUUID"])
+				}
+				return fmt.Sprintf(`{"data":[`+tc.items+`]}`, task["taskUUID"])
+			})}
+			
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			
+			resp, err := sdk.VideoInference(ctx, NewVideoInferenceReq{
+				PositivePrompt: "a paper boat drifting down a rainy street",
+				Model:          "klingai:5@3",
+				Duration:       5,
+				NumberResults:  2,
+				PollInterval:   time.Second,
+			}, nil)
+			
+			require.NoError(t, err)
+			require.Len(t, resp.Videos, 2)
+			for i, video := range resp.Videos {
+				assert.Equal(t, tc.want[i], video.VideoURL)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package runware
 
 import (
 	"reflect"
+	"strings"
+	
+	"github.com/google/uuid"
 )
 
 const (
@@ -18,16 +21,43 @@ const (
 	NewReverseImageClip      = "newReverseImageClip"
 	NewPromptEnhance         = "newPromptEnhance"
 	Pong                     = "ping"
+	
+	// ResponseData is the key under which the current API returns task results
+	ResponseData = "data"
+	// ResponseErrors is the key under which the current API returns task errors
+	ResponseErrors = "errors"
 )
 
+// ExplicitFields names, by JSON key, the fields of a request set on purpose
+// to their zero value, e.g. "strength" for a strength of 0. Defaults do not
+// replace them and they are sent even when their tag omits empty values.
+type ExplicitFields []string
+
+func (f ExplicitFields) has(name string) bool {
+	for _, field := range f {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeEventRequestsWithDefaults sets the zero fields of cfgDest to their value
+// in defaultCfgDest, except for the fields listed in an ExplicitFields field of
+// cfgDest
 func MergeEventRequestsWithDefaults[T any](cfgDest, defaultCfgDest T) error {
 	dstVal := reflect.ValueOf(cfgDest).Elem()
 	defaultVal := reflect.ValueOf(defaultCfgDest).Elem()
+	explicit := explicitFields(dstVal)
 	
 	for i := 0; i < dstVal.NumField(); i++ {
 		dstField := dstVal.Field(i)
 		defaultField := defaultVal.Field(i)
 		
+		if explicit.has(jsonName(dstVal.Type().Field(i))) {
+			continue
+		}
+		
 		if isZeroValues(dstField) {
 			setField(dstField, defaultField)
 		}
@@ -36,6 +66,53 @@ func MergeEventRequestsWithDefaults[T any](cfgDest, defaultCfgDest T) error {
 	return nil
 }
 
+// ensureTaskUUID generates a task UUID unless the request already carries one,
+// so merging defaults again keeps the identity of a task
+func ensureTaskUUID(taskUUID *string) {
+	if *taskUUID == "" {
+		*taskUUID = uuid.New().String()
+	}
+}
+
+func explicitFields(val reflect.Value) ExplicitFields {
+	for i := 0; i < val.NumField(); i++ {
+		if explicit, ok := val.Field(i).Interface().(ExplicitFields); ok {
+			return explicit
+		}
+	}
+	return nil
+}
+
+// withExplicit adds the values of the explicit fields of v, a request struct,
+// to extra so they are sent even when empty. Extra keeps precedence.
+func withExplicit(v interface{}, explicit ExplicitFields, extra map[string]any) map[string]any {
+	if len(explicit) == 0 {
+		return extra
+	}
+	
+	values := make(map[string]any, len(explicit)+len(extra))
+	val := reflect.ValueOf(v)
+	for i := 0; i < val.NumField(); i++ {
+		name := jsonName(val.Type().Field(i))
+		if name != "" && name != "taskType" && name != "taskUUID" && explicit.has(name) {
+			values[name] = val.Field(i).Interface()
+		}
+	}
+	for key, value := range extra {
+		values[key] = value
+	}
+	
+	return values
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
 func isZeroValues(field reflect.Value) bool {
 	switch field.Kind() {
 	case reflect.String:
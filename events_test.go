@@ -0,0 +1,95 @@
+package runware
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultsRespectsExplicitFields(t *testing.T) {
+	req := NewImageInferenceReq{
+		PositivePrompt: "p",
+		Model:          "runware:100@1",
+		SeedImage:      testImageUUID,
+		Explicit:       ExplicitFields{"strength", "CFGScale"},
+	}
+	mergeImageInferenceReqWithDefaults(&req)
+	
+	assert.Equal(t, 0.0, req.Strength)
+	assert.Equal(t, 0.0, req.CFGScale)
+	assert.Equal(t, 20, req.Steps)
+	
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, 0.0, fields["strength"])
+	assert.Equal(t, 0.0, fields["CFGScale"])
+	assert.NotContains(t, fields, "explicit")
+}
+
+func TestImageInferenceDefaultsPerTask(t *testing.T) {
+	textToImage := NewImageInferenceReq{PositivePrompt: "p", Model: "runware:100@1"}
+	mergeImageInferenceReqWithDefaults(&textToImage)
+	assert.Equal(t, 0.0, textToImage.Strength)
+	
+	imageToImage := NewImageInferenceReq{PositivePrompt: "p", Model: "runware:100@1", SeedImage: testImageUUID}
+	mergeImageInferenceReqWithDefaults(&imageToImage)
+	assert.Equal(t, 0.8, imageToImage.Strength)
+}
+
+func TestMergeDefaultsKeepsTaskUUID(t *testing.T) {
+	req := NewImageInferenceReq{PositivePrompt: "p", Model: "runware:100@1"}
+	mergeImageInferenceReqWithDefaults(&req)
+	taskUUID := req.TaskUUID
+	require.NotEmpty(t, taskUUID)
+	
+	mergeImageInferenceReqWithDefaults(&req)
+	assert.Equal(t, taskUUID, req.TaskUUID)
+	assert.Empty(t, NewImageInferenceReqDefaults().TaskUUID)
+}
+
+func TestBuilderKeepsExplicitZero(t *testing.T) {
+	req, err := NewImageToImage("runware:100@1", "p", testImageUUID, 0).Size(1024, 1024).CFGScale(0).Build()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, req.Strength)
+	assert.Equal(t, 0.0, req.CFGScale)
+}
+
+func TestMergeDefaultsRespectsExplicitFieldsOfEveryTask(t *testing.T) {
+	masking := NewImageMaskingReq{
+		InputImage: testImageUUID,
+		Explicit:   ExplicitFields{"confidence", "maskPadding", "maskBlur"},
+	}
+	mergeNewImageMaskingReqWithDefaults(&masking)
+	assert.Equal(t, 0.0, masking.Confidence)
+	assert.Equal(t, 0, masking.MaskPadding)
+	assert.Equal(t, 0, masking.MaskBlur)
+	assert.Equal(t, 6, masking.MaxDetections)
+	require.NoError(t, validateNewImageMaskingReq(masking))
+	
+	photoMaker := NewPhotoMakerReq{CFGScale: 0, Explicit: ExplicitFields{"CFGScale"}}
+	mergeNewPhotoMakerReqWithDefaults(&photoMaker)
+	assert.Equal(t, 0.0, photoMaker.CFGScale)
+	assert.Equal(t, 20, photoMaker.Steps)
+	
+	upscale := NewImageUpscaleReq{Explicit: ExplicitFields{"outputQuality"}}
+	mergeNewImageUpscaleReqWithDefaults(&upscale)
+	assert.Equal(t, 0, upscale.OutputQuality)
+	
+	removeBackground := NewRemoveBackgroundReq{Explicit: ExplicitFields{"outputQuality"}}
+	mergeNewRemoveBackgroundReqWithDefaults(&removeBackground)
+	assert.Equal(t, 0, removeBackground.OutputQuality)
+	
+	data, err := json.Marshal(masking)
+	require.NoError(t, err)
+	
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, 0.0, fields["confidence"])
+	assert.Equal(t, 0.0, fields["maskPadding"])
+	assert.Equal(t, 0.0, fields["maskBlur"])
+}
@@ -26,9 +26,11 @@ func main() {
 	// defer cancel()
 	
 	log.Println("Text to Image")
-	imagesRes, err := sdk.NewImage(ctx, picfinder.NewTaskReq{
-		PromptText:    "neon punk retro futuristic 1970 fallout game vibes like theme rocky deserted villages outside the cities. Air looks dusty un unclean with a tint of red. Debris and rusty cars here and there set the scene",
-		NumberResults: 2,
+	imageRes, err := sdk.ImageInference(ctx, picfinder.NewImageInferenceReq{
+		PositivePrompt: "neon punk retro futuristic 1970 fallout game vibes like theme rocky deserted villages outside the cities. Air looks dusty un unclean with a tint of red. Debris and rusty cars here and there set the scene",
+		Model:          "runware:100@1",
+		Width:          1024,
+		Height:         1024,
 	})
 	if err != nil {
 		if !errors.Is(err, picfinder.ErrRequestTimeout) {
@@ -36,31 +38,33 @@ func main() {
 		}
 	}
 	
-	jsonPrint(imagesRes)
+	jsonPrint(imageRes)
 	
-	workImage := imagesRes.Images[0]
-	log.Println("Image to Image image: UUID", workImage.ImageUUID)
-	imagesRes, err = sdk.NewImage(ctx, picfinder.NewTaskReq{
-		TaskType:           picfinder.ImageToImage,
-		PromptText:         "fallout game vibes like theme rocky deserted villages outside the cities. Air looks dusty un unclean with a tint of red. Debris and rusty cars here and there set the scene",
-		NumberResults:      12,
-		ImageInitiatorUUID: workImage.ImageUUID,
+	workImageUUID := imageRes.ImageUUID
+	log.Println("Image to Image image: UUID", workImageUUID)
+	imageRes, err = sdk.ImageInference(ctx, picfinder.NewImageInferenceReq{
+		PositivePrompt: "fallout game vibes like theme rocky deserted villages outside the cities. Air looks dusty un unclean with a tint of red. Debris and rusty cars here and there set the scene",
+		Model:          "runware:100@1",
+		Width:          1024,
+		Height:         1024,
+		SeedImage:      workImageUUID,
+		Strength:       0.7,
 	})
 	if err != nil {
 		if !errors.Is(err, picfinder.ErrRequestTimeout) {
 			panic(err)
 		}
 	}
-	jsonPrint(imagesRes)
+	jsonPrint(imageRes)
 	
-	log.Println("ControlNets image: UUID", workImage.ImageUUID)
+	log.Println("ControlNets image: UUID", workImageUUID)
 	
 	taskID := uuid.New().String()
 	log.Println("ControlNets TaskUUID:", taskID)
 	cnRes, err := sdk.NewControlNets(ctx, picfinder.NewControlNetsReq{
 		TaskUUID:         taskID,
 		PreProcessorType: picfinder.ProcessorDepth,
-		GuideImageUUID:   workImage.ImageUUID,
+		GuideImageUUID:   workImageUUID,
 	})
 	if err != nil {
 		panic(err)
@@ -68,10 +72,10 @@ func main() {
 	
 	jsonPrint(cnRes)
 	
-	log.Println("ControlNets image: UUID", workImage.ImageUUID)
+	log.Println("Upscale image: UUID", workImageUUID)
 	upscaleRes, err := sdk.ImageUpscale(context.Background(), picfinder.NewUpscaleGanReq{
-		ImageUUID:     workImage.ImageUUID,
-		TaskUUID:      workImage.TaskUUID,
+		ImageUUID:     workImageUUID,
+		TaskUUID:      imageRes.TaskUUID,
 		UpscaleFactor: 2,
 	})
 	
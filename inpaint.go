@@ -0,0 +1,215 @@
+package runware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"os"
+)
+
+// outpaintStep is the multiple the API requires for outpaint extents
+const outpaintStep = 64
+
+// resolvedImage is an image reference ready to be used as a task input, with
+// its size when it could be read locally
+type resolvedImage struct {
+	ref    string
+	width  int
+	height int
+}
+
+// Inpaint repaints the area of image covered by mask. image and mask can be a
+// file path, an image UUID, URL, data URI or base64 string, a []byte, an
+// io.Reader or an image.Image; local images are uploaded first. opts carries
+// the remaining generation parameters (model, steps, ...); when its Width and
+// Height are not set they are derived from the size of image.
+func (sdk *SDK) Inpaint(ctx context.Context, image, mask interface{}, prompt string, opts NewImageInferenceReq) (*NewImageInferenceResp, error) {
+	seed, err := sdk.resolveImage(ctx, image)
+	if err != nil {
+		return nil, fmt.Errorf("%w:[%s]", err, "seedImage")
+	}
+	
+	maskImage, err := sdk.resolveImage(ctx, mask)
+	if err != nil {
+		return nil, fmt.Errorf("%w:[%s]", err, "maskImage")
+	}
+	
+	opts.PositivePrompt = prompt
+	opts.SeedImage = seed.ref
+	opts.MaskImage = maskImage.ref
+	
+	if opts.Width == 0 || opts.Height == 0 {
+		if seed.width == 0 || seed.height == 0 {
+			return nil, fmt.Errorf("%w:[%s when the image size cannot be read]", ErrFieldRequired, "width/height")
+		}
+		opts.Width, opts.Height = imageInferenceProfile(opts).fitDimensions(seed.width, seed.height)
+	}
+	
+	return sdk.ImageInference(ctx, opts)
+}
+
+// Outpaint extends image by extents on each side and fills the new area from
+// prompt. image accepts the same forms as in Inpaint. Extents are rounded up
+// to multiples of 64 and the original area is scaled down when the result
+// would exceed the limits of the model architecture; extents that cannot fit
+// at all fail with ErrFieldIncorrectVal.
+func (sdk *SDK) Outpaint(ctx context.Context, image interface{}, extents Outpaint, prompt string, opts NewImageInferenceReq) (*NewImageInferenceResp, error) {
+	seed, err := sdk.resolveImage(ctx, image)
+	if err != nil {
+		return nil, fmt.Errorf("%w:[%s]", err, "seedImage")
+	}
+	
+	width, height := opts.Width, opts.Height
+	if width == 0 || height == 0 {
+		if seed.width == 0 || seed.height == 0 {
+			return nil, fmt.Errorf("%w:[%s when the image size cannot be read]", ErrFieldRequired, "width/height")
+		}
+		width, height = seed.width, seed.height
+	}
+	
+	opts.Width, opts.Height, extents, err = imageInferenceProfile(opts).outpaintDimensions(width, height, extents)
+	if err != nil {
+		return nil, err
+	}
+	opts.PositivePrompt = prompt
+	opts.SeedImage = seed.ref
+	opts.Outpaint = &extents
+	
+	return sdk.ImageInference(ctx, opts)
+}
+
+// resolveImage turns any supported image source into a reference accepted by
+// task inputs, uploading local data
+func (sdk *SDK) resolveImage(ctx context.Context, src interface{}) (resolvedImage, error) {
+	switch v := src.(type) {
+	case string:
+		if info, err := os.Stat(v); err == nil && !info.IsDir() {
+			data, err := os.ReadFile(v)
+			if err != nil {
+				return resolvedImage{}, err
+			}
+			return sdk.uploadImageBytes(ctx, data)
+		}
+		
+		if err := validateInputImage(v); err != nil {
+			return resolvedImage{}, err
+		}
+		return resolvedImage{ref: v}, nil
+	case []byte:
+		return sdk.uploadImageBytes(ctx, v)
+	case image.Image:
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, v); err != nil {
+			return resolvedImage{}, err
+		}
+		return sdk.uploadImageBytes(ctx, buf.Bytes())
+	case io.Reader:
+		data, err := io.ReadAll(v)
+		if err != nil {
+			return resolvedImage{}, err
+		}
+		return sdk.uploadImageBytes(ctx, data)
+	case nil:
+		return resolvedImage{}, ErrFieldRequired
+	default:
+		return resolvedImage{}, fmt.Errorf("%w:[%T]", ErrImageUnsupported, src)
+	}
+}
+
+func (sdk *SDK) uploadImageBytes(ctx context.Context, data []byte) (resolvedImage, error) {
+	resp, err := sdk.UploadReader(ctx, bytes.NewReader(data))
+	if err != nil {
+		return resolvedImage{}, err
+	}
+	
+	resolved := resolvedImage{ref: resp.ImageUUID}
+	
+	// WEBP has no registered decoder, its size is left unknown
+	if cfg, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		resolved.width, resolved.height = cfg.Width, cfg.Height
+	}
+	
+	return resolved, nil
+}
+
+// fitDimensions scales width and height into the limits of the profile,
+// keeping the aspect ratio, and snaps both to its dimension step
+func (p ArchitectureProfile) fitDimensions(width, height int) (int, int) {
+	return p.snapSize(float64(width)/float64(height), float64(width*height))
+}
+
+// fitDimensionsWithin is like fitDimensions with the dimensions also kept
+// below maxWidth and maxHeight
+func (p ArchitectureProfile) fitDimensionsWithin(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+	if width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if height > maxHeight && float64(maxHeight)/float64(height) < scale {
+		scale = float64(maxHeight) / float64(height)
+	}
+	
+	width, height = p.fitDimensions(int(float64(width)*scale), int(float64(height)*scale))
+	return min(width, maxWidth/p.DimensionStep*p.DimensionStep), min(height, maxHeight/p.DimensionStep*p.DimensionStep)
+}
+
+// outpaintDimensions returns the final width and height of an outpainting
+// request for an image of the given size, with the extents rounded up to
+// multiples of 64 and the blur clamped to its range. The original area is
+// scaled down until the result fits the profile. Extents that leave no room for
+// the original area are reported as an error.
+func (p ArchitectureProfile) outpaintDimensions(width, height int, extents Outpaint) (int, int, Outpaint, error) {
+	roundUp := func(v int) int {
+		if v <= 0 {
+			return 0
+		}
+		return (v + outpaintStep - 1) / outpaintStep * outpaintStep
+	}
+	
+	extents.Top = roundUp(extents.Top)
+	extents.Right = roundUp(extents.Right)
+	extents.Bottom = roundUp(extents.Bottom)
+	extents.Left = roundUp(extents.Left)
+	extents.Blur = clamp(extents.Blur, 0, 32)
+	
+	// Keep room for the new area
+	maxWidth := p.MaxDimension - extents.Left - extents.Right
+	if maxWidth < p.MinDimension {
+		return 0, 0, extents, fmt.Errorf("%w:[%s][left + right <= %d]", ErrFieldIncorrectVal, "outpaint.left/right", p.MaxDimension-p.MinDimension)
+	}
+	maxHeight := p.MaxDimension - extents.Top - extents.Bottom
+	if maxHeight < p.MinDimension {
+		return 0, 0, extents, fmt.Errorf("%w:[%s][top + bottom <= %d]", ErrFieldIncorrectVal, "outpaint.top/bottom", p.MaxDimension-p.MinDimension)
+	}
+	
+	extraWidth, extraHeight := extents.Left+extents.Right, extents.Top+extents.Bottom
+	width, height = p.fitDimensionsWithin(width, height, maxWidth, maxHeight)
+	
+	// Shrink the original area a step at a time until the pixel budget holds
+	for (width+extraWidth)*(height+extraHeight) > p.MaxPixels {
+		if width >= height && width-p.DimensionStep >= p.MinDimension {
+			maxWidth = width - p.DimensionStep
+		} else if height-p.DimensionStep >= p.MinDimension {
+			maxHeight = height - p.DimensionStep
+		} else {
+			break
+		}
+		width, height = p.fitDimensionsWithin(width, height, maxWidth, maxHeight)
+	}
+	
+	return width + extraWidth, height + extraHeight, extents, nil
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,183 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitDimensions(t *testing.T) {
+	testCases := []struct {
+		width, height         int
+		wantWidth, wantHeight int
+	}{
+		{1000, 750, 1024, 768},
+		{4000, 3000, 2048, 1536},
+		{3000, 4000, 1536, 2048},
+		{100, 50, 128, 128},
+		{1920, 1080, 1920, 1088},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%dx%d", tc.width, tc.height), func(t *testing.T) {
+			w, h := defaultProfile.fitDimensions(tc.width, tc.height)
+			assert.Equal(t, tc.wantWidth, w)
+			assert.Equal(t, tc.wantHeight, h)
+		})
+	}
+}
+
+func TestOutpaintDimensions(t *testing.T) {
+	w, h, extents, err := defaultProfile.outpaintDimensions(1024, 1024, Outpaint{Left: 100, Right: 100, Blur: 40})
+	require.NoError(t, err)
+	assert.Equal(t, 1280, w)
+	assert.Equal(t, 1024, h)
+	assert.Equal(t, Outpaint{Left: 128, Right: 128, Blur: 32}, extents)
+	
+	w, h, extents, err = defaultProfile.outpaintDimensions(2048, 2048, Outpaint{Top: 256})
+	require.NoError(t, err)
+	assert.Equal(t, 1792, w)
+	assert.Equal(t, 2048, h)
+	assert.Equal(t, 256, extents.Top)
+	
+	// Every result must pass validation
+	req := NewImageInferenceReq{
+		PositivePrompt: "extend the beach",
+		Model:          "runware:100@1",
+		SeedImage:      "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		Width:          w,
+		Height:         h,
+		Outpaint:       &extents,
+	}
+	assert.NoError(t, validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req)))
+}
+
+func TestOutpaintExtentsTooLarge(t *testing.T) {
+	_, _, _, err := defaultProfile.outpaintDimensions(1024, 1024, Outpaint{Left: 1024, Right: 1024})
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+	assert.ErrorContains(t, err, "outpaint.left/right")
+	
+	_, _, _, err = defaultProfile.outpaintDimensions(1024, 1024, Outpaint{Top: 2048})
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+	assert.ErrorContains(t, err, "outpaint.top/bottom")
+}
+
+func TestDimensionsFollowArchitecture(t *testing.T) {
+	sd1x := ProfileFor(ModelArchitectureSD1x)
+	
+	w, h := sd1x.fitDimensions(1920, 1080)
+	assert.LessOrEqual(t, w*h, sd1x.MaxPixels)
+	assert.Equal(t, AspectWide, NearestAspectRatio(w, h))
+	
+	w, h, extents, err := sd1x.outpaintDimensions(1024, 1024, Outpaint{Left: 128, Right: 128})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, w*h, sd1x.MaxPixels)
+	
+	req := NewImageInferenceReq{
+		PositivePrompt: "extend the beach",
+		Model:          "civitai:4201@130072",
+		SeedImage:      "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		Width:          w,
+		Height:         h,
+		Outpaint:       &extents,
+	}
+	assert.NoError(t, validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req)))
+}
+
+func TestInpaintSD1x(t *testing.T) {
+	listen := make(chan []byte, 1)
+	var sentWidth, sentHeight float64
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			
+			taskUUID := sent[0]["taskUUID"]
+			switch sent[0]["taskType"] {
+			case ImageUpload:
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageUpload","taskUUID":"%s","imageUUID":"4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10"}]}`, taskUUID))
+			case ImageInference:
+				sentWidth, sentHeight = sent[0]["width"].(float64), sent[0]["height"].(float64)
+				listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageInference","taskUUID":"%s","imageUUID":"result"}]}`, taskUUID))
+			}
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	photo := image.NewRGBA(image.Rect(0, 0, 1920, 1080))
+	resp, err := sdk.Inpaint(context.Background(), photo, photo, "a red door", NewImageInferenceReq{
+		Model: "civitai:4201@130072",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "result", resp.ImageUUID)
+	assert.LessOrEqual(t, int(sentWidth*sentHeight), ProfileFor(ModelArchitectureSD1x).MaxPixels)
+}
+
+func TestInpaintRejectsMaskMargin(t *testing.T) {
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return make(chan []byte)
+		},
+		SendFunc: func(b []byte) error {
+			t.Fatal("an invalid request must not be sent")
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	_, err := sdk.Inpaint(context.Background(), "https://example.com/photo.jpg", "https://example.com/mask.png", "a red door", NewImageInferenceReq{
+		Model:      "runware:100@1",
+		Width:      1024,
+		Height:     1024,
+		MaskMargin: 200,
+	})
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, "maskMargin", verr.Violations[0].Path)
+	assert.Equal(t, 200, verr.Violations[0].Value)
+}
+
+func TestResolveImage(t *testing.T) {
+	listen := make(chan []byte, 1)
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			var sent []map[string]interface{}
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			assert.Equal(t, ImageUpload, sent[0]["taskType"])
+			assert.Contains(t, sent[0]["image"], "data:image/png;base64,")
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageUpload","taskUUID":"%s","imageUUID":"uploaded-uuid"}]}`, sent[0]["taskUUID"]))
+			return nil
+		},
+	}
+	sdk := &SDK{Client: mClient}
+	
+	resolved, err := sdk.resolveImage(context.Background(), image.NewRGBA(image.Rect(0, 0, 300, 200)))
+	require.NoError(t, err)
+	assert.Equal(t, resolvedImage{ref: "uploaded-uuid", width: 300, height: 200}, resolved)
+	
+	resolved, err = sdk.resolveImage(context.Background(), "https://example.com/photo.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/photo.jpg", resolved.ref)
+	
+	_, err = sdk.resolveImage(context.Background(), 42)
+	assert.ErrorIs(t, err, ErrImageUnsupported)
+}
@@ -0,0 +1,117 @@
+package runware
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ModelID is an AIR model identifier, source:id@version, such as
+// runware:100@1 or civitai:4201@130072
+type ModelID string
+
+// legacyModels maps every legacy model constant to the release it named. The
+// numeric API never said which release the others pointed at, so they map to
+// nothing and LegacyModelID reports them instead of guessing.
+var legacyModels = map[int]ModelID{
+	ModelSDXL:               "civitai:101055@128078",
+	ModelRevAnimated:        "",
+	ModelAbsolutereality:    "civitai:81458@132760",
+	ModelCyberrealistic:     "",
+	ModelDreamshaper:        "civitai:4384@128713",
+	ModelGhostmixBakedvae:   "",
+	ModelSamaritan3DCartoon: "",
+}
+
+// ParseModelID validates s as an AIR identifier
+func ParseModelID(s string) (ModelID, error) {
+	if !isValidAIR(s) {
+		return "", fmt.Errorf("%w:[%s][source:id@version]", ErrFieldIncorrectVal, "model")
+	}
+	return ModelID(s), nil
+}
+
+// MustModelID is like ParseModelID but panics on an invalid identifier. It is
+// meant for constants.
+func MustModelID(s string) ModelID {
+	id, err := ParseModelID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LegacyModelID returns the identifier of a legacy model constant such as
+// ModelSDXL. Constants without a known release fail with ErrLegacyModelUnmapped;
+// look their AIR identifier up with ModelSearch.
+func LegacyModelID(model int) (ModelID, error) {
+	id, ok := legacyModels[model]
+	if !ok {
+		return "", fmt.Errorf("%w:[%s][unknown legacy model %d]", ErrFieldIncorrectVal, "model", model)
+	}
+	if id == "" {
+		return "", fmt.Errorf("%w:[%d]", ErrLegacyModelUnmapped, model)
+	}
+	return id, nil
+}
+
+// Source returns the registry of the model, e.g. runware or civitai
+func (id ModelID) Source() string {
+	source, _, _ := strings.Cut(string(id), ":")
+	return source
+}
+
+// ID returns the model identifier within its source
+func (id ModelID) ID() string {
+	_, rest, _ := strings.Cut(string(id), ":")
+	modelID, _, _ := strings.Cut(rest, "@")
+	return modelID
+}
+
+// Version returns the version of the model
+func (id ModelID) Version() string {
+	_, version, _ := strings.Cut(string(id), "@")
+	return version
+}
+
+// Valid reports whether id is a well formed AIR identifier
+func (id ModelID) Valid() bool {
+	return isValidAIR(string(id))
+}
+
+func (id ModelID) String() string {
+	return string(id)
+}
+
+func (id ModelID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(id))
+}
+
+// UnmarshalJSON accepts AIR strings only. An empty string is left to the
+// required checks of the request it belongs to.
+func (id *ModelID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*id = ""
+		return nil
+	}
+	
+	parsed, err := ParseModelID(s)
+	if err != nil {
+		return err
+	}
+	
+	*id = parsed
+	return nil
+}
+
+// check reports id at path unless it is a well formed AIR identifier. Missing
+// models are left to the required checks.
+func (id ModelID) check(v *validation, path string) {
+	if id != "" && !id.Valid() {
+		v.incorrect(path, "source:id@version", string(id))
+	}
+}
@@ -0,0 +1,116 @@
+package runware
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModelID(t *testing.T) {
+	id, err := ParseModelID("civitai:4201@130072")
+	require.NoError(t, err)
+	assert.Equal(t, "civitai", id.Source())
+	assert.Equal(t, "4201", id.ID())
+	assert.Equal(t, "130072", id.Version())
+	
+	for _, s := range []string{"", "runware:100", "runware100@1", "Runware:100@1", "runware:100@1 "} {
+		_, err := ParseModelID(s)
+		assert.True(t, errors.Is(err, ErrFieldIncorrectVal), s)
+	}
+}
+
+func TestModelIDJSON(t *testing.T) {
+	var v struct {
+		Model ModelID `json:"model"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"model":"runware:100@1"}`), &v))
+	assert.Equal(t, ModelID("runware:100@1"), v.Model)
+	
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"runware:100@1"}`, string(data))
+	
+	assert.Error(t, json.Unmarshal([]byte(`{"model":"runware-100"}`), &v))
+}
+
+func TestLegacyModelID(t *testing.T) {
+	id, err := LegacyModelID(ModelSDXL)
+	require.NoError(t, err)
+	assert.True(t, id.Valid())
+	
+	_, err = LegacyModelID(1000)
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+	
+	// Every legacy constant either maps to a valid identifier or says it cannot
+	for _, model := range []int{
+		ModelSDXL, ModelRevAnimated, ModelAbsolutereality, ModelCyberrealistic,
+		ModelDreamshaper, ModelGhostmixBakedvae, ModelSamaritan3DCartoon,
+	} {
+		id, err := LegacyModelID(model)
+		if err != nil {
+			assert.True(t, errors.Is(err, ErrLegacyModelUnmapped), model)
+			continue
+		}
+		assert.True(t, id.Valid(), model)
+	}
+	
+	_, err = LegacyModelID(ModelRevAnimated)
+	assert.True(t, errors.Is(err, ErrLegacyModelUnmapped))
+}
+
+func TestImageInferenceReqModelID(t *testing.T) {
+	req := NewImageInferenceReq{PositivePrompt: "p", Model: MustModelID("runware:100@1")}
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	
+	var sent map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &sent))
+	assert.Equal(t, "runware:100@1", sent["model"])
+	
+	var decoded NewImageInferenceReq
+	require.NoError(t, json.Unmarshal([]byte(`{"positivePrompt":"p"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"model":"runware-100"}`), &decoded))
+}
+
+func TestValidateImageInferenceReqModelIDs(t *testing.T) {
+	req := NewImageInferenceReq{
+		PositivePrompt: "p",
+		Model:          "runware100@1",
+		Width:          1024,
+		Height:         1024,
+		Lora:           []Lora{{Model: "civitai:123@1"}, {Model: "civitai:123"}},
+	}
+	
+	err := validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req))
+	
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	require.Len(t, verr.Violations, 2)
+	assert.Equal(t, "model", verr.Violations[0].Path)
+	assert.Equal(t, "lora[1].model", verr.Violations[1].Path)
+	assert.Equal(t, "civitai:123", verr.Violations[1].Value)
+}
+
+func TestNestedModelIDs(t *testing.T) {
+	req, err := NewTextToImage("runware:100@1", "p").
+		Size(1024, 1024).
+		WithLora(MustModelID("civitai:123@1"), 0.8).
+		WithEmbedding("civitai:456", 1).
+		Build()
+	require.Error(t, err)
+	assert.Empty(t, req.Lora)
+	
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	require.Len(t, verr.Violations, 1)
+	assert.Equal(t, "embeddings[0].model", verr.Violations[0].Path)
+	
+	err = validateNewVideoInferenceReq(*mergeNewVideoInferenceReqWithDefaults(&NewVideoInferenceReq{
+		PositivePrompt: "p",
+		Model:          "klingai-5",
+	}))
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+}
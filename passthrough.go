@@ -0,0 +1,57 @@
+package runware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// marshalWithExtra marshals a request and merges extra into the resulting
+// object, so parameters the SDK does not model yet can still be sent. Extra
+// values replace modelled fields of the same name, except for taskType and
+// taskUUID which route the task.
+func marshalWithExtra(v interface{}, extra map[string]any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	
+	for key, value := range extra {
+		if key == "taskType" || key == "taskUUID" {
+			return nil, fmt.Errorf("%w:[extra.%s][set on the request instead]", ErrFieldIncorrectVal, key)
+		}
+		
+		bValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w:[extra.%s][%s]", ErrFieldIncorrectVal, key, err.Error())
+		}
+		fields[key] = bValue
+	}
+	
+	return json.Marshal(fields)
+}
+
+// unmarshalWithRaw decodes a response and keeps a copy of its payload in raw,
+// so fields the SDK does not model are not lost
+func unmarshalWithRaw(data []byte, v interface{}, raw *json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	
+	*raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// rawArray joins the payloads of a response built from several items
+func rawArray(items [][]byte) json.RawMessage {
+	if len(items) == 0 {
+		return nil
+	}
+	
+	return append(append(json.RawMessage("["), bytes.Join(items, []byte(","))...), ']')
+}
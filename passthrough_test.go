@@ -0,0 +1,75 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestExtra(t *testing.T) {
+	req := NewImageInferenceReq{
+		TaskType:       ImageInference,
+		TaskUUID:       "task-uuid",
+		PositivePrompt: "a lighthouse",
+		Model:          "runware:100@1",
+		Width:          512,
+		Height:         512,
+		Steps:          20,
+		Extra: map[string]any{
+			"futureOption": map[string]any{"level": 2},
+			"steps":        4,
+		},
+	}
+	
+	b, err := json.Marshal(req)
+	require.NoError(t, err)
+	
+	var sent map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &sent))
+	assert.Equal(t, map[string]interface{}{"level": float64(2)}, sent["futureOption"])
+	assert.Equal(t, float64(4), sent["steps"])
+	assert.Equal(t, "task-uuid", sent["taskUUID"])
+	assert.NotContains(t, sent, "Extra")
+	
+	// Without extra fields the payload is unchanged
+	req.Extra = nil
+	b, err = json.Marshal(req)
+	require.NoError(t, err)
+	sent = nil
+	require.NoError(t, json.Unmarshal(b, &sent))
+	assert.NotContains(t, sent, "futureOption")
+	
+	req.Extra = map[string]any{"taskUUID": "other"}
+	_, err = json.Marshal(req)
+	assert.ErrorIs(t, err, ErrFieldIncorrectVal)
+}
+
+func TestResponseRaw(t *testing.T) {
+	var sent []map[string]interface{}
+	listen := make(chan []byte, 1)
+	sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		sent = append(sent, task)
+		return fmt.Sprintf(`{"data":[{"taskType":"imageCaption","taskUUID":"%s","text":"a cat","futureScore":0.9}]}`, task["taskUUID"])
+	})}
+	
+	resp, err := sdk.ImageCaption(context.Background(), NewImageCaptionReq{
+		InputImage: "https://example.com/cat.png",
+		Extra:      map[string]any{"detail": "high"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a cat", resp.Text)
+	assert.Equal(t, "high", sent[0]["detail"])
+	
+	var raw map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Raw, &raw))
+	assert.Equal(t, 0.9, raw["futureScore"])
+}
+
+func TestRawArray(t *testing.T) {
+	assert.Nil(t, rawArray(nil))
+	assert.JSONEq(t, `[{"a":1},{"b":2}]`, string(rawArray([][]byte{[]byte(`{"a":1}`), []byte(`{"b":2}`)})))
+}
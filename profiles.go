@@ -0,0 +1,281 @@
+package runware
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ModelArchitectureProvider is the profile of models run by an external
+// provider, such as bfl:1@1. It is only used for validation and is never sent.
+const ModelArchitectureProvider = "provider"
+
+// ArchitectureProfile holds the image inference limits of a model architecture
+type ArchitectureProfile struct {
+	Architecture string
+
+	// Width and height must be multiples of DimensionStep within
+	// MinDimension-MaxDimension, and their product at most MaxPixels
+	DimensionStep int
+	MinDimension  int
+	MaxDimension  int
+	MaxPixels     int
+
+	MinSteps    int
+	MaxSteps    int
+	MaxCFGScale float64
+
+	// Schedulers lists the supported schedulers. Nil accepts any scheduler,
+	// an empty list none.
+	Schedulers []string
+
+	// Supported features
+	ClipSkip    bool
+	Refiner     bool
+	ControlNet  bool
+	Lora        bool
+	Embeddings  bool
+	IPAdapters  bool
+	PuLID       bool
+	ACEPlusPlus bool
+}
+
+// defaultProfile applies to models of unknown architecture and keeps the
+// limits the API accepts for any model
+var defaultProfile = ArchitectureProfile{
+	DimensionStep: 64,
+	MinDimension:  128,
+	MaxDimension:  2048,
+	MaxPixels:     2048 * 2048,
+	MinSteps:      1,
+	MaxSteps:      100,
+	MaxCFGScale:   50,
+	ClipSkip:      true,
+	Refiner:       true,
+	ControlNet:    true,
+	Lora:          true,
+	Embeddings:    true,
+	IPAdapters:    true,
+	PuLID:         true,
+	ACEPlusPlus:   true,
+}
+
+var flowMatchSchedulers = []string{"Default", "FlowMatchEulerDiscreteScheduler"}
+
+var (
+	profilesMu sync.RWMutex
+
+	architectureProfiles = map[string]ArchitectureProfile{
+		ModelArchitectureSD1x:      sdProfile(ModelArchitectureSD1x, 1024*1024, 100, false),
+		ModelArchitectureSDHyper:   sdProfile(ModelArchitectureSDHyper, 1024*1024, 50, false),
+		ModelArchitectureSDXL:      sdProfile(ModelArchitectureSDXL, 2048*2048, 100, true),
+		ModelArchitectureSDXLHyper: sdProfile(ModelArchitectureSDXLHyper, 2048*2048, 50, true),
+		ModelArchitecturePony:      sdProfile(ModelArchitecturePony, 2048*2048, 100, true),
+		ModelArchitectureSD3: {
+			Architecture:  ModelArchitectureSD3,
+			DimensionStep: 16,
+			MinDimension:  128,
+			MaxDimension:  2048,
+			MaxPixels:     2048 * 2048,
+			MinSteps:      1,
+			MaxSteps:      100,
+			MaxCFGScale:   50,
+			Schedulers:    flowMatchSchedulers,
+			ControlNet:    true,
+			Lora:          true,
+		},
+		ModelArchitectureFlux1S: fluxProfile(ModelArchitectureFlux1S, 50),
+		ModelArchitectureFlux1D: fluxProfile(ModelArchitectureFlux1D, 100),
+		ModelArchitectureProvider: {
+			Architecture:  ModelArchitectureProvider,
+			DimensionStep: 16,
+			MinDimension:  128,
+			MaxDimension:  2048,
+			MaxPixels:     2048 * 2048,
+			MinSteps:      1,
+			MaxSteps:      100,
+			MaxCFGScale:   50,
+			Schedulers:    []string{},
+		},
+	}
+
+	// modelArchitectures maps well known models to their architecture
+	modelArchitectures = map[string]string{
+		"runware:100@1":         ModelArchitectureFlux1S,
+		"runware:101@1":         ModelArchitectureFlux1D,
+		"runware:5@1":           ModelArchitectureSD3,
+		"civitai:101055@128078": ModelArchitectureSDXL,
+		"civitai:4201@130072":   ModelArchitectureSD1x,
+		"civitai:257749@290640": ModelArchitecturePony,
+	}
+
+	// providerSources are the AIR sources of models run by external providers
+	providerSources = map[string]bool{
+		"bfl":       true,
+		"openai":    true,
+		"google":    true,
+		"ideogram":  true,
+		"bytedance": true,
+		"klingai":   true,
+		"minimax":   true,
+	}
+)
+
+func sdProfile(architecture string, maxPixels, maxSteps int, refiner bool) ArchitectureProfile {
+	return ArchitectureProfile{
+		Architecture:  architecture,
+		DimensionStep: 64,
+		MinDimension:  128,
+		MaxDimension:  2048,
+		MaxPixels:     maxPixels,
+		MinSteps:      1,
+		MaxSteps:      maxSteps,
+		MaxCFGScale:   50,
+		ClipSkip:      true,
+		Refiner:       refiner,
+		ControlNet:    true,
+		Lora:          true,
+		Embeddings:    true,
+		IPAdapters:    true,
+	}
+}
+
+func fluxProfile(architecture string, maxSteps int) ArchitectureProfile {
+	return ArchitectureProfile{
+		Architecture:  architecture,
+		DimensionStep: 16,
+		MinDimension:  128,
+		MaxDimension:  2048,
+		MaxPixels:     2048 * 2048,
+		MinSteps:      1,
+		MaxSteps:      maxSteps,
+		MaxCFGScale:   50,
+		Schedulers:    flowMatchSchedulers,
+		ControlNet:    true,
+		Lora:          true,
+		IPAdapters:    true,
+		PuLID:         true,
+		ACEPlusPlus:   true,
+	}
+}
+
+// RegisterArchitectureProfile adds or replaces the profile of an architecture
+func RegisterArchitectureProfile(profile ArchitectureProfile) {
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	
+	architectureProfiles[profile.Architecture] = profile
+}
+
+// RegisterModelArchitecture records the architecture of a model, so requests
+// using it are validated against the matching profile
+func RegisterModelArchitecture(model, architecture string) {
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	
+	modelArchitectures[model] = architecture
+}
+
+// ModelArchitecture returns the architecture of a registered or provider
+// model, or an empty string when it is unknown
+func ModelArchitecture(model string) string {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+	
+	if architecture, ok := modelArchitectures[model]; ok {
+		return architecture
+	}
+	
+	source, _, found := strings.Cut(model, ":")
+	if found && providerSources[source] {
+		return ModelArchitectureProvider
+	}
+	
+	return ""
+}
+
+// ProfileFor returns the profile of architecture, falling back to the limits
+// the API accepts for any model when it is unknown
+func ProfileFor(architecture string) ArchitectureProfile {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+	
+	if profile, ok := architectureProfiles[architecture]; ok {
+		return profile
+	}
+	return defaultProfile
+}
+
+// imageInferenceProfile selects the profile of req, from its Architecture when
+// set or from its model otherwise
+func imageInferenceProfile(req NewImageInferenceReq) ArchitectureProfile {
+	if req.Architecture != "" {
+		return ProfileFor(req.Architecture)
+	}
+	return ProfileFor(ModelArchitecture(string(req.Model)))
+}
+
+func (p ArchitectureProfile) checkSize(v *validation, width, height int) {
+	constraint := fmt.Sprintf("%d-%d, divisible by %d", p.MinDimension, p.MaxDimension, p.DimensionStep)
+	if width < p.MinDimension || width > p.MaxDimension || width%p.DimensionStep != 0 {
+		v.incorrect("width", constraint, width)
+	}
+	if height < p.MinDimension || height > p.MaxDimension || height%p.DimensionStep != 0 {
+		v.incorrect("height", constraint, height)
+	}
+	
+	if width*height > p.MaxPixels {
+		v.incorrect("width*height", fmt.Sprintf("at most %d pixels", p.MaxPixels), width*height)
+	}
+}
+
+func (p ArchitectureProfile) checkSteps(v *validation, steps int) {
+	if steps < p.MinSteps || steps > p.MaxSteps {
+		v.incorrect("steps", fmt.Sprintf("%d-%d", p.MinSteps, p.MaxSteps), steps)
+	}
+}
+
+func (p ArchitectureProfile) checkCFGScale(v *validation, scale float64) {
+	if scale < 0 || scale > p.MaxCFGScale {
+		v.incorrect("CFGScale", fmt.Sprintf("0-%g", p.MaxCFGScale), scale)
+	}
+}
+
+func (p ArchitectureProfile) checkScheduler(v *validation, scheduler string) {
+	if scheduler == "" || p.Schedulers == nil {
+		return
+	}
+	
+	for _, s := range p.Schedulers {
+		if s == scheduler {
+			return
+		}
+	}
+	
+	v.incorrect("scheduler", strings.Join(p.Schedulers, ", "), scheduler)
+}
+
+// checkFeatures reports the features req uses that the architecture does not
+// support
+func (p ArchitectureProfile) checkFeatures(v *validation, req NewImageInferenceReq) {
+	features := []struct {
+		name      string
+		used      bool
+		supported bool
+	}{
+		{"clipSkip", req.ClipSkip != nil, p.ClipSkip},
+		{"refiner", req.Refiner != nil, p.Refiner},
+		{"controlNet", len(req.ControlNet) > 0, p.ControlNet},
+		{"lora", len(req.Lora) > 0, p.Lora},
+		{"embeddings", len(req.Embeddings) > 0, p.Embeddings},
+		{"ipAdapters", len(req.IPAdapters) > 0, p.IPAdapters},
+		{"puLID", req.PuLID != nil, p.PuLID},
+		{"acePlusPlus", req.ACEPlusPlus != nil, p.ACEPlusPlus},
+	}
+	
+	for _, f := range features {
+		if f.used && !f.supported {
+			v.incorrect(f.name, "not supported by "+p.Architecture, nil)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package runware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelArchitecture(t *testing.T) {
+	assert.Equal(t, ModelArchitectureFlux1S, ModelArchitecture("runware:100@1"))
+	assert.Equal(t, ModelArchitectureProvider, ModelArchitecture("bfl:2@1"))
+	assert.Equal(t, "", ModelArchitecture("civitai:1@1"))
+	
+	RegisterModelArchitecture("civitai:1@1", ModelArchitectureSD1x)
+	t.Cleanup(func() {
+		profilesMu.Lock()
+		delete(modelArchitectures, "civitai:1@1")
+		profilesMu.Unlock()
+	})
+	assert.Equal(t, ModelArchitectureSD1x, ModelArchitecture("civitai:1@1"))
+}
+
+func TestValidateImageInferenceReqProfiles(t *testing.T) {
+	clipSkip := 1
+	
+	testCases := []struct {
+		name    string
+		modify  func(req *NewImageInferenceReq)
+		wantErr string
+	}{
+		{"FLUX accepts multiples of 16", func(req *NewImageInferenceReq) {
+			req.Width, req.Height = 1008, 1008
+		}, ""},
+		{"unknown model keeps multiples of 64", func(req *NewImageInferenceReq) {
+			req.Model = "civitai:1@1"
+			req.Width = 1008
+		}, "[width][128-2048, divisible by 64]"},
+		{"SD1.5 rejects large images", func(req *NewImageInferenceReq) {
+			req.Architecture = ModelArchitectureSD1x
+			req.Width, req.Height = 2048, 2048
+		}, "[width*height]"},
+		{"FLUX schnell steps", func(req *NewImageInferenceReq) {
+			req.Steps = 80
+		}, "[steps][1-50]"},
+		{"FLUX schedulers", func(req *NewImageInferenceReq) {
+			req.Scheduler = "EulerDiscreteScheduler"
+		}, "[scheduler]"},
+		{"SDXL schedulers", func(req *NewImageInferenceReq) {
+			req.Architecture = ModelArchitectureSDXL
+			req.Scheduler = "EulerDiscreteScheduler"
+		}, ""},
+		{"FLUX clipSkip", func(req *NewImageInferenceReq) {
+			req.ClipSkip = &clipSkip
+		}, "[clipSkip][not supported by flux1s]"},
+		{"provider lora", func(req *NewImageInferenceReq) {
+			req.Model = "bfl:2@1"
+			req.Lora = []Lora{{Model: "civitai:123@1", Weight: 1}}
+		}, "[lora][not supported by provider]"},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := NewImageInferenceReq{
+				PositivePrompt: "a lighthouse",
+				Model:          "runware:100@1",
+				Width:          1024,
+				Height:         1024,
+			}
+			tc.modify(&req)
+			
+			err := validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req))
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestBuilderUsesProfile(t *testing.T) {
+	_, err := NewTextToImage("runware:101@1", "p").Size(1040, 1040).Build()
+	require.NoError(t, err)
+	
+	_, err = NewTextToImage("runware:101@1", "p").Architecture(ModelArchitectureSDXL).Size(1040, 1040).Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[width]")
+}
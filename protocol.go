@@ -0,0 +1,165 @@
+package runware
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error codes of the current API mapped to SDK errors
+var apiErrorCodes = map[string]error{
+	"invalidApiKey":       ErrInvalidApiKey,
+	"missingApiKey":       ErrInvalidApiKey,
+	"insufficientCredits": ErrLowBalance,
+}
+
+// APIError is a task error sent by the server in the errors envelope of the
+// current protocol. It matches ErrInvalidApiKey, ErrLowBalance or ErrTaskFailed
+// through errors.Is depending on its code.
+type APIError struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Parameter string `json:"parameter,omitempty"`
+	TaskType  string `json:"taskType,omitempty"`
+	TaskUUID  string `json:"taskUUID,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Parameter != "" {
+		return fmt.Sprintf("%s:[%s:%s][%s]", e.Unwrap().Error(), e.Code, e.Message, e.Parameter)
+	}
+	return fmt.Sprintf("%s:[%s:%s]", e.Unwrap().Error(), e.Code, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	if err, ok := apiErrorCodes[e.Code]; ok {
+		return err
+	}
+	return ErrTaskFailed
+}
+
+// maxPongSize bounds the messages checked for heartbeat answers, so the read
+// loop never decodes result frames
+const maxPongSize = 128
+
+// frame is the routing view of an incoming message, decoded once per message.
+// Result items keep their raw bytes and are only decoded into their final
+// type by the caller waiting for them.
+type frame struct {
+	Data   []frameItem `json:"data"`
+	Errors []APIError  `json:"errors"`
+
+	// Legacy error fields
+	Error        interface{} `json:"error"`
+	ErrorID      interface{} `json:"errorId"`
+	ErrorMessage interface{} `json:"errorMessage"`
+}
+
+// frameItem is a result item with its routing keys peeked
+type frameItem struct {
+	TaskType string
+	TaskUUID string
+	Raw      json.RawMessage
+	
+	// Last marks the last item of its frame for its taskUUID
+	Last bool
+}
+
+func (item *frameItem) UnmarshalJSON(data []byte) error {
+	var keys struct {
+		TaskType string `json:"taskType"`
+		TaskUUID string `json:"taskUUID"`
+	}
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	
+	item.TaskType = keys.TaskType
+	item.TaskUUID = keys.TaskUUID
+	item.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+func decodeFrame(msg []byte) (*frame, error) {
+	f := &frame{}
+	if err := json.Unmarshal(msg, f); err != nil {
+		return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+	}
+	
+	last := make(map[string]int, len(f.Data))
+	for i, item := range f.Data {
+		last[item.TaskUUID] = i
+	}
+	for _, i := range last {
+		f.Data[i].Last = true
+	}
+	
+	return f, nil
+}
+
+// decodeLegacyFields splits a legacy message into its top level events
+func decodeLegacyFields(msg []byte) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+	}
+	return fields, nil
+}
+
+// legacyValue returns the value of a legacy event, reduced to its first
+// element when it is an array. It is empty when the array is.
+func legacyValue(value json.RawMessage) (json.RawMessage, error) {
+	if len(value) == 0 || value[0] != '[' {
+		return value, nil
+	}
+	
+	var values []json.RawMessage
+	if err := json.Unmarshal(value, &values); err != nil {
+		return nil, fmt.Errorf("%w:[%s]", ErrDecodeMessage, err.Error())
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	
+	return values[0], nil
+}
+
+// legacyErr returns the error of a legacy error message
+func (f *frame) legacyErr() (error, bool) {
+	if f.Error != true {
+		return nil, false
+	}
+	return legacyError(f.ErrorID, f.ErrorMessage), true
+}
+
+// legacyError maps the errorId of a legacy error message to an SDK error
+func legacyError(errorID, errorMessage interface{}) error {
+	var err error
+	switch errorID {
+	case float64(19):
+		err = ErrInvalidApiKey
+		// Add more
+	default:
+		err = ErrWsUnknownError
+	}
+	
+	return fmt.Errorf("%w:[%v:%s]", err, errorID, errorMessage)
+}
+
+// isPong reports whether msg answers a heartbeat, in either protocol
+func isPong(msg []byte) bool {
+	if len(msg) > maxPongSize {
+		return false
+	}
+	
+	var pong struct {
+		Ping interface{} `json:"ping"`
+		Data []struct {
+			TaskType string `json:"taskType"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(msg, &pong); err != nil {
+		return false
+	}
+	
+	return pong.Ping != nil || (len(pong.Data) == 1 && pong.Data[0].TaskType == Pong)
+}
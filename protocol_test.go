@@ -0,0 +1,188 @@
+package runware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectAuthentication(t *testing.T) {
+	var sentTask map[string]interface{}
+	listen := make(chan []byte, 2)
+	sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		sentTask = task
+		// The authentication of another request is not taken for this one
+		listen <- []byte(`{"data":[{"taskType":"authentication","taskUUID":"other-task","connectionSessionUUID":"other-session"}]}`)
+		return fmt.Sprintf(`{"data":[{"taskType":"authentication","taskUUID":"%s","connectionSessionUUID":"session-uuid"}]}`, task["taskUUID"])
+	})}
+	
+	resp, err := sdk.Connect(context.Background(), NewConnectReq{APIKey: "test-api-key"})
+	require.NoError(t, err)
+	assert.Equal(t, "session-uuid", resp.ConnectionSessionUUID)
+	assert.Equal(t, Authentication, sentTask["taskType"])
+	assert.Equal(t, "test-api-key", sentTask["apiKey"])
+	assert.NotEmpty(t, sentTask["taskUUID"])
+	
+	listen = make(chan []byte, 1)
+	sdk = &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		return fmt.Sprintf(`{"errors":[{"code":"invalidApiKey","message":"Invalid API key","taskType":"authentication","taskUUID":"%s"}]}`, task["taskUUID"])
+	})}
+	
+	_, err = sdk.Connect(context.Background(), NewConnectReq{APIKey: "test-api-key"})
+	assert.ErrorIs(t, err, ErrInvalidApiKey)
+}
+
+func TestErrorsEnvelope(t *testing.T) {
+	testCases := []struct {
+		name    string
+		reply   string
+		wantErr error
+	}{
+		{
+			name:    "Invalid API key",
+			reply:   `{"errors":[{"code":"invalidApiKey","message":"Invalid API key"}]}`,
+			wantErr: ErrInvalidApiKey,
+		},
+		{
+			name:    "Task error",
+			reply:   `{"errors":[{"code":"invalidModel","message":"Unknown model","parameter":"model","taskType":"imageInference","taskUUID":"{taskUUID}"}]}`,
+			wantErr: ErrTaskFailed,
+		},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listen := make(chan []byte, 1)
+			sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+				return strings.ReplaceAll(tc.reply, "{taskUUID}", task["taskUUID"].(string))
+			})}
+			
+			_, err := sdk.ImageInference(context.Background(), NewImageInferenceReq{
+				PositivePrompt: "a lighthouse",
+				Model:          "runware:100@1",
+				Width:          512,
+				Height:         512,
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+			
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+		})
+	}
+}
+
+func TestImageInferenceProtocols(t *testing.T) {
+	req := NewImageInferenceReq{
+		PositivePrompt: "a lighthouse",
+		Model:          "runware:100@1",
+		Width:          512,
+		Height:         512,
+	}
+	
+	listen := make(chan []byte, 2)
+	sdk := &SDK{Client: echoClient(listen, func(task map[string]interface{}) string {
+		// Results of other tasks on the same connection are skipped
+		listen <- []byte(`{"data":[{"taskType":"imageInference","taskUUID":"other-task","imageUUID":"other"}]}`)
+		return fmt.Sprintf(`{"data":[{"taskType":"imageInference","taskUUID":"%s","imageUUID":"image-uuid"}]}`, task["taskUUID"])
+	})}
+	
+	resp, err := sdk.ImageInference(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "image-uuid", resp.ImageUUID)
+	
+	legacyListen := make(chan []byte, 1)
+	sdk = &SDK{legacy: true, Client: echoClient(legacyListen, func(task map[string]interface{}) string {
+		return `{"newImages":[{"imageUUID":"legacy-image-uuid"}]}`
+	})}
+	
+	resp, err = sdk.ImageInference(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-image-uuid", resp.ImageUUID)
+}
+
+func TestIsPong(t *testing.T) {
+	assert.True(t, isPong([]byte(`{"ping": true}`)))
+	assert.True(t, isPong([]byte(`{"data":[{"taskType":"ping","pong":true}]}`)))
+	assert.False(t, isPong([]byte(`{"data":[{"taskType":"imageInference"}]}`)))
+	assert.False(t, isPong([]byte(`not json`)))
+}
+
+func TestDecodeFrame(t *testing.T) {
+	f, err := decodeFrame([]byte(`{"data":[{"taskType":"imageInference","taskUUID":"a","imageUUID":"1"},{"taskType":"imageInference","taskUUID":"b"}],"errors":[{"code":"invalidModel","taskUUID":"c"}]}`))
+	require.NoError(t, err)
+	require.Len(t, f.Data, 2)
+	assert.Equal(t, "a", f.Data[0].TaskUUID)
+	assert.Equal(t, ImageInference, f.Data[0].TaskType)
+	assert.JSONEq(t, `{"taskType":"imageInference","taskUUID":"a","imageUUID":"1"}`, string(f.Data[0].Raw))
+	require.Len(t, f.Errors, 1)
+	assert.ErrorIs(t, &f.Errors[0], ErrTaskFailed)
+	
+	_, err = decodeFrame([]byte(`{"data":`))
+	assert.ErrorIs(t, err, ErrDecodeMessage)
+	
+	fields, err := decodeLegacyFields([]byte(`{"newImages":[{"imageUUID":"1"},{"imageUUID":"2"}]}`))
+	require.NoError(t, err)
+	value, err := legacyValue(fields[NewImage])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"imageUUID":"1"}`, string(value))
+	
+	f, err = decodeFrame([]byte(`{"error":true,"errorId":19,"errorMessage":"Invalid API key"}`))
+	require.NoError(t, err)
+	err, ok := f.legacyErr()
+	assert.True(t, ok)
+	assert.ErrorIs(t, err, ErrInvalidApiKey)
+}
+
+// base64Frame is a data message carrying a large inline image
+func base64Frame(b *testing.B) []byte {
+	image := strings.Repeat("iVBORw0KGgoAAAANSUhEUgAA", 1<<15)
+	msg := fmt.Sprintf(`{"data":[{"taskType":"imageInference","taskUUID":"task-uuid","imageUUID":"image-uuid","imageBase64Data":"%s","seed":42,"cost":0.0013}]}`, image)
+	b.SetBytes(int64(len(msg)))
+	return []byte(msg)
+}
+
+// BenchmarkDecodeMapPath measures the previous decoding, which went through
+// map[string]interface{} and re-marshaled every item before the typed decode
+func BenchmarkDecodeMapPath(b *testing.B) {
+	msg := base64Frame(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	
+	for i := 0; i < b.N; i++ {
+		var msgData map[string]interface{}
+		if err := json.Unmarshal(msg, &msgData); err != nil {
+			b.Fatal(err)
+		}
+		item := msgData[ResponseData].([]interface{})[0]
+		bItem, err := json.Marshal(item)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var resp NewImageInferenceResp
+		if err = json.Unmarshal(bItem, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeFrame(b *testing.B) {
+	msg := base64Frame(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	
+	for i := 0; i < b.N; i++ {
+		f, err := decodeFrame(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var resp NewImageInferenceResp
+		if err = json.Unmarshal(f.Data[0].Raw, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
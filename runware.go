@@ -1,7 +1,6 @@
 package runware
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -42,6 +41,9 @@ type runware struct {
 	connStr          ConnAddr
 	client           *websocket.Conn
 	incomingMessages chan []byte
+	overflow         OverflowPolicy
+	legacy           bool
+	debug            bool
 	
 	reconnectAttempt int
 	reconnectChan    chan struct{}
@@ -75,8 +77,9 @@ func (r *runware) Send(msg []byte) error {
 		return ErrOutgoingIsNil
 	}
 	
-	log.Println("send");
-	log.Println(string(msg));
+	if r.debug {
+		log.Printf("[send]: %s\n", msg)
+	}
 	return r.client.WriteMessage(websocket.TextMessage, msg)
 }
 
@@ -123,7 +126,11 @@ func (r *runware) heartbeatLoop() {
 		select {
 		case <-ticker.C:
 			log.Println("Ping ...")
-			if err := r.Send([]byte(`{"ping": true}`)); err != nil {
+			ping := []byte(`[{"taskType":"ping","ping":true}]`)
+			if r.legacy {
+				ping = []byte(`{"ping": true}`)
+			}
+			if err := r.Send(ping); err != nil {
 				log.Println("Ping err", err)
 				r.reconnectAttempt = 1
 				r.reconnectChan <- struct{}{}
@@ -161,19 +168,47 @@ func (r *runware) readLoop() {
 			break
 		}
 		
-		var msgData map[string]interface{}
-		_ = json.Unmarshal(msg, &msgData)
-		if _, ok := msgData[Pong]; ok {
-			log.Println("Pong", msgData[Pong])
+		if isPong(msg) {
+			log.Println("Pong")
 			continue
 		}
 		
-		fmt.Printf("[readLoop]: %+v\n", string(msg))
+		if r.debug {
+			log.Printf("[readLoop]: %s\n", msg)
+		}
 		
+		r.enqueue(msg)
+	}
+	
+	log.Println("[readLoop] closed")
+}
+
+// enqueue buffers an incoming message, applying the overflow policy when the
+// buffer is full
+func (r *runware) enqueue(msg []byte) {
+	if r.overflow == OverflowBlock {
 		r.incomingMessages <- msg
+		return
 	}
 	
-	fmt.Print("[readLoop] closed")
+	for {
+		select {
+		case r.incomingMessages <- msg:
+			return
+		default:
+		}
+		
+		if r.overflow == OverflowDropNewest {
+			log.Println("Message buffer full, dropping incoming message")
+			return
+		}
+		
+		select {
+		case <-r.incomingMessages:
+			log.Println("Message buffer full, dropping oldest message")
+		default:
+		}
+	}
 }
 
 // reconnectLoop monitor and attempts to reconnect
@@ -219,6 +254,10 @@ func New(cfg RunwareConfig) (Runware, error) {
 		cfg.ConnAddr = ProdEnv
 	}
 	
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = defaultBufferSize
+	}
+	
 	client, err := wsConnect(cfg.ConnAddr.String(), cfg.APIKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w:[%s]", ErrWsDial, cfg.ConnAddr.String())
@@ -228,7 +267,10 @@ func New(cfg RunwareConfig) (Runware, error) {
 		apiKey:           cfg.APIKey,
 		connStr:          cfg.ConnAddr,
 		client:           client,
-		incomingMessages: make(chan []byte),
+		incomingMessages: make(chan []byte, cfg.BufferSize),
+		overflow:         cfg.Overflow,
+		legacy:           cfg.LegacyProtocol,
+		debug:            cfg.Debug,
 		reconnectChan:    make(chan struct{}),
 		reconnectedChan:  make(chan struct{}),
 	}
@@ -242,17 +284,6 @@ func New(cfg RunwareConfig) (Runware, error) {
 	return r, nil
 }
 
-func interfaceToByte(v interface{}) ([]byte, error) {
-	switch val := v.(type) {
-	case []byte:
-		return val, nil
-	case string:
-		return []byte(val), nil
-	default:
-		return json.Marshal(v)
-	}
-}
-
 func wsConnect(connStr string, apiKey string) (*websocket.Conn, error) {
 	headers := make(http.Header)
 	headers.Set("Authorization", "Bearer "+apiKey)
@@ -0,0 +1,67 @@
+package runware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// BenchmarkReadLoop measures a result from the socket to the request waiting
+// for it, through readLoop, the message buffer and the dispatcher
+func BenchmarkReadLoop(b *testing.B) {
+	msg := base64Frame(b)
+	
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		
+		for i := 0; i < b.N; i++ {
+			if err = conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return
+			}
+		}
+		// Keep the connection open until the client is done
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+	
+	conn, err := wsConnect("ws"+strings.TrimPrefix(server.URL, "http"), "test-api-key")
+	if err != nil {
+		b.Fatal(err)
+	}
+	
+	r := &runware{
+		client:           conn,
+		incomingMessages: make(chan []byte, defaultBufferSize),
+		reconnectChan:    make(chan struct{}, 1),
+	}
+	sdk := &SDK{Client: r}
+	d := sdk.dispatch()
+	w := d.waitTasks([]string{"task-uuid"}, waiterBuffer)
+	defer d.release(w)
+	
+	b.ReportAllocs()
+	b.ResetTimer()
+	go r.readLoop()
+	
+	for i := 0; i < b.N; i++ {
+		select {
+		case item := <-w.items:
+			if item.TaskUUID != "task-uuid" {
+				b.Fatalf("unexpected task %q", item.TaskUUID)
+			}
+		case err = <-w.errs:
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+	
+	_ = r.Close()
+}
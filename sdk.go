@@ -3,14 +3,26 @@ package runware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"sync"
+	"time"
 )
 
+// retryBackoff is the delay before the first retry, doubled on every attempt
+const retryBackoff = 250 * time.Millisecond
+
 type SDK struct {
 	Client Runware
 	
 	sessionKey string
+	maxRetries int
+	legacy     bool
+	
+	dispatchOnce sync.Once
+	dispatcher   *dispatcher
 }
 
 func NewSDK(cfg SDKConfig) (*SDK, error) {
@@ -21,12 +33,13 @@ func NewSDK(cfg SDKConfig) (*SDK, error) {
 	}
 	
 	sdk := &SDK{
-		Client: client,
+		Client:     client,
+		maxRetries: cfg.MaxRetries,
+		legacy:     cfg.LegacyProtocol,
 	}
 	
 	res, err := sdk.Connect(context.Background(), NewConnectReq{
 		APIKey: sdk.Client.APIKey(),
-		TaskType: "ping",
 	})
 
 	if err != nil {
@@ -44,30 +57,8 @@ func NewSDK(cfg SDKConfig) (*SDK, error) {
 }
 
 func (sdk *SDK) OnError(msg map[string]interface{}) (error, bool) {
-	var (
-		hasError       = false
-		err      error = nil
-	)
-	
-	for k, v := range msg {
-		if k == "error" {
-			if v == true {
-				hasError = true
-			}
-		}
-		if k == "errorId" {
-			switch v {
-			case float64(19):
-				err = ErrInvalidApiKey
-				// Add more
-			default:
-				err = ErrWsUnknownError
-			}
-		}
-	}
-	
-	if hasError {
-		return fmt.Errorf("%w:[%v:%s]", err, msg["errorId"], msg["errorMessage"]), hasError
+	if msg["error"] == true {
+		return legacyError(msg["errorId"], msg["errorMessage"]), true
 	}
 	return nil, false
 }
@@ -79,7 +70,6 @@ func (sdk *SDK) onReconnected() {
 			_, err := sdk.Connect(context.Background(), NewConnectReq{
 				APIKey:                sdk.Client.APIKey(),
 				ConnectionSessionUUID: sdk.sessionKey,
-				TaskType: "ping",
 			})
 			if err != nil {
 				log.Println("Reconnect failed:", err)
@@ -97,7 +87,12 @@ type Request struct {
 }
 
 func (req Request) ToEvent() ([]byte, error) {
-
+	
+	// Task lists are already in the array form the API expects
+	if reflect.ValueOf(req.Data).Kind() == reflect.Slice {
+		return json.Marshal(req.Data)
+	}
+	
 	reqM := [] interface{} { req.Data }
 
 	return json.Marshal(reqM)
@@ -109,9 +104,13 @@ func makeClient(cfg SDKConfig) (Runware, error) {
 	}
 	
 	client, err := New(RunwareConfig{
-		APIKey:    cfg.APIKey,
-		ConnAddr:  cfg.ConnAddr,
-		KeepAlive: false,
+		APIKey:         cfg.APIKey,
+		ConnAddr:       cfg.ConnAddr,
+		KeepAlive:      false,
+		LegacyProtocol: cfg.LegacyProtocol,
+		BufferSize:     cfg.BufferSize,
+		Overflow:       cfg.Overflow,
+		Debug:          cfg.Debug,
 	})
 	if err != nil {
 		return nil, err
@@ -119,3 +118,202 @@ func makeClient(cfg SDKConfig) (Runware, error) {
 	
 	return client, nil
 }
+
+// sendTask sends a task request and waits for the response item carrying the
+// same taskUUID under sendReq.ResponseEvent, decoding it into resp. Unsent
+// tasks, and timed out idempotent ones, are resent with the same taskUUID up to
+// maxRetries times.
+func (sdk *SDK) sendTask(ctx context.Context, sendReq Request, taskUUID string, resp interface{}) error {
+	return sdk.withRetry(ctx, sendReq.Event, func() error {
+		items, err := sdk.sendTasks(ctx, sendReq, map[string]int{taskUUID: 1})
+		if err != nil {
+			return err
+		}
+		
+		return json.Unmarshal(items[taskUUID][0], resp)
+	})
+}
+
+// sendEvent sends a request using the legacy event protocol and decodes the
+// value of sendReq.ResponseEvent from the first message carrying it into
+// resp. Legacy events have no task correlation; arrays are reduced to their
+// first element.
+func (sdk *SDK) sendEvent(ctx context.Context, sendReq Request, resp interface{}) error {
+	return sdk.withRetry(ctx, sendReq.Event, func() error {
+		w := sdk.dispatch().waitEvent(sendReq.ResponseEvent)
+		defer sdk.dispatcher.release(w)
+		
+		if err := sdk.sendRequest(sendReq); err != nil {
+			return err
+		}
+		
+		select {
+		case value := <-w.items:
+			return json.Unmarshal(value.Raw, resp)
+		case err := <-w.errs:
+			return err
+		case <-time.After(timeoutSendResponse * time.Second):
+			return fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// withRetry runs send for a request of the given event until it succeeds,
+// fails with an error that is not worth retrying or maxRetries retries have
+// been made
+func (sdk *SDK) withRetry(ctx context.Context, event string, send func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := send()
+		if err == nil || attempt >= sdk.maxRetries || !isRetryable(event, err) {
+			return err
+		}
+		
+		select {
+		case <-time.After(retryBackoff << attempt):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// idempotentTasks are the tasks and legacy events that only read state or do
+// no billed work, so resending them after a timeout is harmless
+var idempotentTasks = map[string]bool{
+	GetResponse:       true,
+	AccountManagement: true,
+	ModelSearch:       true,
+	ImageUpload:       true,
+	Authentication:    true,
+	NewConnection:     true,
+	NewImageUpload:    true,
+}
+
+// isRetryable reports whether a request of the given event can be resent after
+// err. A request that could not be sent never reached the server and is always
+// resent. A timed out one may still be running there, so it is only resent
+// when it is idempotent; paid tasks are never run twice.
+func isRetryable(event string, err error) bool {
+	if errors.Is(err, ErrSendFailed) {
+		return true
+	}
+	return errors.Is(err, ErrRequestTimeout) && idempotentTasks[event]
+}
+
+// sendTasks sends one or more tasks in a single message and collects the raw
+// response items keyed by taskUUID, waiting for the expected number of items
+// per task. On timeout the items received so far are returned along with
+// ErrRequestTimeout.
+func (sdk *SDK) sendTasks(ctx context.Context, sendReq Request, expected map[string]int) (map[string][][]byte, error) {
+	total := 0
+	taskUUIDs := make([]string, 0, len(expected))
+	pending := make(map[string]int, len(expected))
+	for taskUUID, count := range expected {
+		taskUUIDs = append(taskUUIDs, taskUUID)
+		pending[taskUUID] = count
+		total += count
+	}
+	
+	w := sdk.dispatch().waitTasks(taskUUIDs, total)
+	defer sdk.dispatcher.release(w)
+	
+	if err := sdk.sendRequest(sendReq); err != nil {
+		return nil, err
+	}
+	
+	timeout := time.After(timeoutSendResponse * time.Second)
+	results := make(map[string][][]byte, len(expected))
+	for received := 0; received < total; {
+		select {
+		case item := <-w.items:
+			if pending[item.TaskUUID] == 0 {
+				continue
+			}
+			pending[item.TaskUUID]--
+			received++
+			
+			results[item.TaskUUID] = append(results[item.TaskUUID], item.Raw)
+		case err := <-w.errs:
+			return results, err
+		case <-timeout:
+			return results, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
+		case <-ctx.Done():
+			return results, ctx.Err()
+		}
+	}
+	
+	return results, nil
+}
+
+// sendTaskFrame sends a task and returns every raw item carried for taskUUID
+// by the first frame answering it, for tasks whose item count is not known in
+// advance
+func (sdk *SDK) sendTaskFrame(ctx context.Context, sendReq Request, taskUUID string) ([][]byte, error) {
+	w := sdk.dispatch().waitTasks([]string{taskUUID}, waiterBuffer)
+	defer sdk.dispatcher.release(w)
+	
+	if err := sdk.sendRequest(sendReq); err != nil {
+		return nil, err
+	}
+	
+	timeout := time.After(timeoutSendResponse * time.Second)
+	var items [][]byte
+	for {
+		select {
+		case item := <-w.items:
+			items = append(items, item.Raw)
+			if item.Last {
+				return items, nil
+			}
+		case err := <-w.errs:
+			return items, err
+		case <-timeout:
+			return items, fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
+		case <-ctx.Done():
+			return items, ctx.Err()
+		}
+	}
+}
+
+// streamTask sends a task that answers with several status items and passes
+// each of them to handle until it reports the task as finished. The timeout
+// restarts after every item, so long running tasks only fail when the server
+// goes quiet.
+func (sdk *SDK) streamTask(ctx context.Context, sendReq Request, taskUUID string, handle func(item []byte) (bool, error)) error {
+	w := sdk.dispatch().waitTasks([]string{taskUUID}, waiterBuffer)
+	defer sdk.dispatcher.release(w)
+	
+	if err := sdk.sendRequest(sendReq); err != nil {
+		return err
+	}
+	
+	for {
+		select {
+		case item := <-w.items:
+			finished, err := handle(item.Raw)
+			if err != nil || finished {
+				return err
+			}
+		case err := <-w.errs:
+			return err
+		case <-time.After(timeoutSendResponse * time.Second):
+			return fmt.Errorf("%w:[%s]", ErrRequestTimeout, sendReq.Event)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (sdk *SDK) sendRequest(sendReq Request) error {
+	bSendReq, err := sendReq.ToEvent()
+	if err != nil {
+		return err
+	}
+	
+	if err = sdk.Client.Send(bSendReq); err != nil {
+		return fmt.Errorf("%w:[%w]", ErrSendFailed, err)
+	}
+	
+	return nil
+}
@@ -1,6 +1,8 @@
 package runware
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	
@@ -160,6 +162,44 @@ func (s *SDKTestSuite) Test_ErrorMap() {
 	}
 }
 
+func (s *SDKTestSuite) Test_ImageCaptionBatch() {
+	listen := make(chan []byte, 1)
+	var sent []map[string]interface{}
+	
+	mClient := &MockRunware{
+		ListenFunc: func() chan []byte {
+			return listen
+		},
+		SendFunc: func(b []byte) error {
+			if err := json.Unmarshal(b, &sent); err != nil {
+				return err
+			}
+			// Reply out of order to exercise taskUUID correlation
+			listen <- []byte(fmt.Sprintf(`{"data":[{"taskType":"imageCaption","taskUUID":"%s","text":"second"},{"taskType":"imageCaption","taskUUID":"%s","text":"first"}]}`,
+				sent[1]["taskUUID"], sent[0]["taskUUID"]))
+			return nil
+		},
+	}
+	sdk := SDK{Client: mClient}
+	
+	reqs := []NewImageCaptionReq{
+		{InputImage: "https://example.com/first.png"},
+		{InputImage: "https://example.com/second.png"},
+	}
+	resps, err := sdk.ImageCaptionBatch(context.Background(), reqs)
+	
+	s.Require().NoError(err)
+	s.Require().Len(sent, 2)
+	s.Equal(ImageCaption, sent[0]["taskType"])
+	s.Require().Len(resps, 2)
+	s.Equal("first", resps[0].Text)
+	s.Equal("second", resps[1].Text)
+	
+	// The caller's requests are not modified
+	s.Equal(NewImageCaptionReq{InputImage: "https://example.com/first.png"}, reqs[0])
+	s.Equal(NewImageCaptionReq{InputImage: "https://example.com/second.png"}, reqs[1])
+}
+
 func (s *SDKTestSuite) TearDownTest() {
 	fmt.Println("Tear down")
 }
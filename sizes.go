@@ -0,0 +1,202 @@
+package runware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// AspectRatio is a width to height ratio such as 16:9
+type AspectRatio struct {
+	Width  int
+	Height int
+}
+
+// Common aspect ratios
+var (
+	AspectSquare    = AspectRatio{1, 1}
+	AspectLandscape = AspectRatio{4, 3}
+	AspectPortrait  = AspectRatio{3, 4}
+	AspectPhoto     = AspectRatio{3, 2}
+	AspectPhotoTall = AspectRatio{2, 3}
+	AspectWide      = AspectRatio{16, 9}
+	AspectTall      = AspectRatio{9, 16}
+	AspectUltraWide = AspectRatio{21, 9}
+	AspectUltraTall = AspectRatio{9, 21}
+	AspectPanorama  = AspectRatio{2, 1}
+	AspectBanner    = AspectRatio{1, 2}
+	AspectPrint     = AspectRatio{5, 4}
+	AspectPrintTall = AspectRatio{4, 5}
+)
+
+var commonAspectRatios = []AspectRatio{
+	AspectSquare, AspectLandscape, AspectPortrait, AspectPhoto, AspectPhotoTall,
+	AspectWide, AspectTall, AspectUltraWide, AspectUltraTall, AspectPanorama,
+	AspectBanner, AspectPrint, AspectPrintTall,
+}
+
+// aspectRatioTolerance is how far, relatively, a size may be from a common
+// ratio to be described by it. It covers sizes snapped to a dimension step,
+// such as 832x1216 for 2:3.
+const aspectRatioTolerance = 0.03
+
+// legacySizes holds the dimensions the legacy Size constants stood for
+var legacySizes = map[int][2]int{
+	SizeSquare512:          {512, 512},
+	SizePortrait2to3:       {512, 768},
+	SizePortrait1to2:       {512, 1024},
+	SizeLandscape2to3:      {768, 512},
+	SizeLandscape2to1:      {1024, 512},
+	SizeLandscape4to3:      {768, 576},
+	SizeLandscape16to9:     {1024, 576},
+	SizePortrait9to16:      {576, 1024},
+	SizePortrait3to4:       {576, 768},
+	SizeSquare1024SDXL:     {1024, 1024},
+	SizeLandscape16to9SDXL: {1344, 768},
+	SizePortrait9to16SDXL:  {768, 1344},
+	SizePortrait2to3SDXL:   {832, 1216},
+	SizeLandscape3to2SDXL:  {1216, 832},
+}
+
+// ParseAspectRatio reads a ratio written as W:H, e.g. 16:9
+func ParseAspectRatio(s string) (AspectRatio, error) {
+	w, h, found := strings.Cut(s, ":")
+	width, errW := strconv.Atoi(w)
+	height, errH := strconv.Atoi(h)
+	if !found || errW != nil || errH != nil || width <= 0 || height <= 0 {
+		return AspectRatio{}, fmt.Errorf("%w:[%s][W:H, e.g. 16:9]", ErrFieldIncorrectVal, "aspectRatio")
+	}
+	
+	return AspectRatio{width, height}, nil
+}
+
+// NearestAspectRatio describes width x height as the closest common ratio
+// when it is within 3% of one, and as its reduced ratio otherwise
+func NearestAspectRatio(width, height int) AspectRatio {
+	if width <= 0 || height <= 0 {
+		return AspectRatio{}
+	}
+	
+	ratio := float64(width) / float64(height)
+	best, bestDiff := AspectRatio{}, aspectRatioTolerance
+	for _, r := range commonAspectRatios {
+		if diff := math.Abs(ratio/r.value() - 1); diff <= bestDiff {
+			best, bestDiff = r, diff
+		}
+	}
+	if best.Width != 0 {
+		return best
+	}
+	
+	d := gcd(width, height)
+	return AspectRatio{width / d, height / d}
+}
+
+func (r AspectRatio) String() string {
+	return fmt.Sprintf("%d:%d", r.Width, r.Height)
+}
+
+// Orientation returns square, landscape or portrait
+func (r AspectRatio) Orientation() string {
+	switch {
+	case r.Width == r.Height:
+		return "square"
+	case r.Width > r.Height:
+		return "landscape"
+	default:
+		return "portrait"
+	}
+}
+
+// Size returns the width and height closest to the ratio and to megapixels
+// million pixels that the architecture accepts. Sizes are reduced to fit its
+// maximum dimensions and pixel count.
+func (r AspectRatio) Size(megapixels float64, architecture string) (int, int, error) {
+	if r.Width <= 0 || r.Height <= 0 {
+		return 0, 0, fmt.Errorf("%w:[%s][positive W:H]", ErrFieldIncorrectVal, "aspectRatio")
+	}
+	if megapixels <= 0 {
+		return 0, 0, fmt.Errorf("%w:[%s][>0]", ErrFieldIncorrectVal, "megapixels")
+	}
+	
+	width, height := ProfileFor(architecture).snapSize(r.value(), megapixels*1e6)
+	return width, height, nil
+}
+
+// LegacySize returns the width and height of a legacy Size constant such as
+// SizeLandscape16to9SDXL, adjusted to the limits of the architecture
+func LegacySize(size int, architecture string) (int, int, error) {
+	dims, ok := legacySizes[size]
+	if !ok {
+		return 0, 0, fmt.Errorf("%w:[%s][unknown legacy size %d]", ErrFieldIncorrectVal, "size", size)
+	}
+	
+	profile := ProfileFor(architecture)
+	width, height := dims[0], dims[1]
+	if !fitsProfile(profile, width, height) {
+		width, height = profile.snapSize(float64(width)/float64(height), float64(width*height))
+	}
+	
+	return width, height, nil
+}
+
+func (r AspectRatio) value() float64 {
+	return float64(r.Width) / float64(r.Height)
+}
+
+func fitsProfile(p ArchitectureProfile, width, height int) bool {
+	v := &validation{}
+	p.checkSize(v, width, height)
+	return len(v.violations) == 0
+}
+
+// snapSize returns the size of the given ratio and pixel count the profile
+// accepts: scaled down to its limits and rounded to its dimension step
+func (p ArchitectureProfile) snapSize(ratio, pixels float64) (int, int) {
+	pixels = math.Min(pixels, float64(p.MaxPixels))
+	width := math.Sqrt(pixels * ratio)
+	height := width / ratio
+	
+	maxDim := float64(p.MaxDimension)
+	if width > maxDim {
+		width, height = maxDim, maxDim/ratio
+	}
+	if height > maxDim {
+		width, height = maxDim*ratio, maxDim
+	}
+	
+	w, h := p.snapDimension(width), p.snapDimension(height)
+	
+	// Rounding up may exceed the pixel budget, give back a step on the larger
+	// side until it fits
+	for w*h > p.MaxPixels {
+		if w >= h && w-p.DimensionStep >= p.MinDimension {
+			w -= p.DimensionStep
+		} else if h-p.DimensionStep >= p.MinDimension {
+			h -= p.DimensionStep
+		} else {
+			break
+		}
+	}
+	
+	return w, h
+}
+
+// snapDimension rounds v to the nearest multiple of the dimension step within
+// the accepted range
+func (p ArchitectureProfile) snapDimension(v float64) int {
+	step := p.DimensionStep
+	snapped := int(math.Round(v/float64(step))) * step
+	
+	lowest := (p.MinDimension + step - 1) / step * step
+	highest := p.MaxDimension / step * step
+	return clamp(snapped, lowest, highest)
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
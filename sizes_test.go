@@ -0,0 +1,97 @@
+package runware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAspectRatioSize(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		ratio                 AspectRatio
+		megapixels            float64
+		architecture          string
+		wantWidth, wantHeight int
+	}{
+		{"SDXL square", AspectSquare, 1, ModelArchitectureSDXL, 1024, 1024},
+		{"SDXL wide", AspectWide, 1, ModelArchitectureSDXL, 1344, 768},
+		{"FLUX wide", AspectWide, 1, ModelArchitectureFlux1D, 1328, 752},
+		{"SD1.5 capped to its pixels", AspectSquare, 4, ModelArchitectureSD1x, 1024, 1024},
+		{"capped to the max dimension", AspectUltraWide, 4, ModelArchitectureSDXL, 2048, 896},
+		{"unknown architecture", AspectTall, 1, "", 768, 1344},
+	}
+	
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, h, err := tc.ratio.Size(tc.megapixels, tc.architecture)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantWidth, w)
+			assert.Equal(t, tc.wantHeight, h)
+			
+			req := NewImageInferenceReq{PositivePrompt: "p", Model: "civitai:1@1", Architecture: tc.architecture, Width: w, Height: h}
+			assert.NoError(t, validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req)))
+		})
+	}
+	
+	_, _, err := AspectRatio{16, 0}.Size(1, ModelArchitectureSDXL)
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+	_, _, err = AspectSquare.Size(0, ModelArchitectureSDXL)
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+}
+
+func TestLegacySize(t *testing.T) {
+	w, h, err := LegacySize(SizeLandscape16to9SDXL, ModelArchitectureSDXL)
+	require.NoError(t, err)
+	assert.Equal(t, 1344, w)
+	assert.Equal(t, 768, h)
+	
+	// Too many pixels for SD 1.5, scaled down keeping the ratio
+	w, h, err = LegacySize(SizeLandscape16to9SDXL, ModelArchitectureSD1x)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, w*h, 1024*1024)
+	assert.Equal(t, AspectWide, NearestAspectRatio(w, h))
+	
+	_, _, err = LegacySize(99, ModelArchitectureSDXL)
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+}
+
+func TestNearestAspectRatio(t *testing.T) {
+	testCases := []struct {
+		width, height int
+		want          string
+		orientation   string
+	}{
+		{1024, 1024, "1:1", "square"},
+		{1344, 768, "16:9", "landscape"},
+		{832, 1216, "2:3", "portrait"},
+		{1920, 1088, "16:9", "landscape"},
+		{1000, 700, "10:7", "landscape"},
+	}
+	
+	for _, tc := range testCases {
+		r := NearestAspectRatio(tc.width, tc.height)
+		assert.Equal(t, tc.want, r.String())
+		assert.Equal(t, tc.orientation, r.Orientation())
+	}
+}
+
+func TestParseAspectRatio(t *testing.T) {
+	r, err := ParseAspectRatio("21:9")
+	require.NoError(t, err)
+	assert.Equal(t, AspectUltraWide, r)
+	
+	for _, s := range []string{"", "16", "16:0", "a:b", "-1:2"} {
+		_, err := ParseAspectRatio(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestBuilderAspectRatio(t *testing.T) {
+	req, err := NewTextToImage("runware:101@1", "p").AspectRatio(AspectWide, 1).Build()
+	require.NoError(t, err)
+	assert.Equal(t, 1328, req.Width)
+	assert.Equal(t, 752, req.Height)
+}
@@ -4,7 +4,8 @@ package runware
 const (
 	ImageInference            = "imageInference"
 	TextToImage               = "textToImage"
-	ImageToImage              = "imageCaption"
+	ImageToImage              = "imageToImage"
+	ImageCaption              = "imageCaption"
 	Inpainting                = "inpainting"
 	ImageToText               = "imageToText"
 	PromptEnhancer            = "promptEnhancer"
@@ -14,6 +15,16 @@ const (
 	ControlNetTextToImage     = "controlNetTextToImage"
 	ControlNetImageToImage    = "controlNetImageToImage"
 	ControlNetPreprocessImage = "controlNetPreprocessImage"
+	ModelSearch               = "modelSearch"
+	ModelUpload               = "modelUpload"
+	VideoInference            = "videoInference"
+	GetResponse               = "getResponse"
+	ImageMasking              = "imageMasking"
+	PhotoMaker                = "photoMaker"
+	Vectorize                 = "vectorize"
+	AccountManagement         = "accountManagement"
+	ControlNetPreprocess      = "imageControlNetPreProcess"
+	Authentication            = "authentication"
 )
 
 // Output types
@@ -28,6 +39,27 @@ const (
 	OutputFormatJPG  = "JPG"
 	OutputFormatPNG  = "PNG"
 	OutputFormatWEBP = "WEBP"
+	OutputFormatSVG  = "SVG"
+)
+
+// Video output formats
+const (
+	OutputFormatMP4  = "MP4"
+	OutputFormatWEBM = "WEBM"
+	OutputFormatMOV  = "MOV"
+)
+
+// Async task statuses
+const (
+	TaskStatusProcessing = "processing"
+	TaskStatusSuccess    = "success"
+	TaskStatusError      = "error"
+)
+
+// Account management operations
+const (
+	AccountOperationGetDetails = "getDetails"
+	AccountOperationGetUsage   = "getUsage"
 )
 
 // Delivery methods
@@ -42,7 +74,8 @@ const (
 	PromptWeightingSDEmbeds = "sdEmbeds"
 )
 
-// Available models (legacy constants for backward compatibility)
+// Available models (legacy constants for backward compatibility, see
+// LegacyModelID for their AIR identifiers)
 const (
 	ModelSDXL               = 4
 	ModelRevAnimated        = 13
@@ -53,6 +86,48 @@ const (
 	ModelSamaritan3DCartoon = 25
 )
 
+// Model categories
+const (
+	ModelCategoryCheckpoint = "checkpoint"
+	ModelCategoryLora       = "lora"
+	ModelCategoryLycoris    = "lycoris"
+	ModelCategoryControlNet = "controlnet"
+	ModelCategoryVAE        = "vae"
+	ModelCategoryEmbeddings = "embeddings"
+)
+
+// Model architectures
+const (
+	ModelArchitectureSD1x      = "sd1x"
+	ModelArchitectureSDHyper   = "sdhyper"
+	ModelArchitectureSDXL      = "sdxl"
+	ModelArchitectureSDXLHyper = "sdxlhyper"
+	ModelArchitecturePony      = "pony"
+	ModelArchitectureSD3       = "sd3"
+	ModelArchitectureFlux1S    = "flux1s"
+	ModelArchitectureFlux1D    = "flux1d"
+)
+
+// Checkpoint model types
+const (
+	ModelTypeBase       = "base"
+	ModelTypeInpainting = "inpainting"
+	ModelTypeRefiner    = "refiner"
+)
+
+// Model file formats
+const (
+	ModelFormatSafetensors = "safetensors"
+	ModelFormatCkpt        = "ckpt"
+)
+
+// Model visibility
+const (
+	ModelVisibilityPublic  = "public"
+	ModelVisibilityPrivate = "private"
+	ModelVisibilityAll     = "all"
+)
+
 // Available processors
 const (
 	ProcessorCanny        = "canny"
@@ -69,7 +144,8 @@ const (
 	ProcessorSoftedge     = "softedge"
 )
 
-// Available sizes (legacy constants for backward compatibility)
+// Available sizes (legacy constants for backward compatibility, see
+// LegacySize for their dimensions)
 const (
 	SizeSquare512          = 1
 	SizePortrait2to3       = 2
@@ -96,7 +172,7 @@ const (
 
 // ControlNet represents a ControlNet configuration for guided image generation
 type ControlNet struct {
-	Model               string  `json:"model"`
+	Model               ModelID `json:"model"`
 	GuideImage          string  `json:"guideImage"`
 	Weight              float64 `json:"weight,omitempty"`
 	StartStep           *int    `json:"startStep,omitempty"`
@@ -108,26 +184,26 @@ type ControlNet struct {
 
 // Lora represents a LoRA (Low-Rank Adaptation) configuration
 type Lora struct {
-	Model  string  `json:"model"`
+	Model  ModelID `json:"model"`
 	Weight float64 `json:"weight,omitempty"`
 }
 
 // Refiner represents SDXL refiner configuration for two-stage generation
 type Refiner struct {
-	Model               string `json:"model"`
-	StartStep           *int   `json:"startStep,omitempty"`
-	StartStepPercentage *int   `json:"startStepPercentage,omitempty"`
+	Model               ModelID `json:"model"`
+	StartStep           *int    `json:"startStep,omitempty"`
+	StartStepPercentage *int    `json:"startStepPercentage,omitempty"`
 }
 
 // Embedding represents an embedding (Textual Inversion) configuration
 type Embedding struct {
-	Model  string  `json:"model"`
+	Model  ModelID `json:"model"`
 	Weight float64 `json:"weight,omitempty"`
 }
 
 // IPAdapter represents an IP-Adapter configuration for image-prompted generation
 type IPAdapter struct {
-	Model      string  `json:"model"`
+	Model      ModelID `json:"model"`
 	GuideImage string  `json:"guideImage"`
 	Weight     float64 `json:"weight,omitempty"`
 }
@@ -290,6 +366,9 @@ type PreProcessControlNet struct {
 	Height             int    `json:"height"`
 	LowThresholdCanny  int    `json:"lowThresholdCanny"`
 	HighThresholdCanny int    `json:"highThresholdCanny"`
+
+	Explicit ExplicitFields `json:"-"`
+	Extra    map[string]any `json:"-"`
 }
 
 // Task represents a legacy task structure (deprecated, use ImageInferenceRequest instead)
@@ -0,0 +1,147 @@
+package runware
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// validateInputImage checks an image reference accepted by task inputs: an
+// image UUID returned by a previous task, a public URL, a data URI or a raw
+// base64 string.
+func validateInputImage(v string) error {
+	if v == "" {
+		return ErrFieldRequired
+	}
+	
+	if isImageUUID(v) || isImageURL(v) {
+		return nil
+	}
+	
+	_, err := isValidBase64Image(v)
+	return err
+}
+
+func isImageUUID(v string) bool {
+	_, err := uuid.Parse(v)
+	return err == nil && len(v) == 36
+}
+
+func isImageURL(v string) bool {
+	return strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://")
+}
+
+// validateOutputOptions checks the output configuration shared by the image
+// producing tasks. Empty values are left to the server defaults.
+func validateOutputOptions(outputType, outputFormat string, outputQuality int) error {
+	v := &validation{}
+	checkOutputOptions(v, outputType, outputFormat, outputQuality)
+	return v.err()
+}
+
+func checkOutputOptions(v *validation, outputType, outputFormat string, outputQuality int) {
+	switch outputType {
+	case "", OutputTypeURL, OutputTypeBase64Data, OutputTypeDataURI:
+	default:
+		v.incorrect("outputType", strings.Join([]string{OutputTypeURL, OutputTypeBase64Data, OutputTypeDataURI}, ", "), outputType)
+	}
+	
+	switch outputFormat {
+	case "", OutputFormatJPG, OutputFormatPNG, OutputFormatWEBP:
+	default:
+		v.incorrect("outputFormat", strings.Join([]string{OutputFormatJPG, OutputFormatPNG, OutputFormatWEBP}, ", "), outputFormat)
+	}
+	
+	if outputQuality != 0 && (outputQuality < 20 || outputQuality > 99) {
+		v.incorrect("outputQuality", "20-99", outputQuality)
+	}
+}
+
+var airPattern = regexp.MustCompile(`^[a-z0-9_-]+:[a-zA-Z0-9_.-]+@[a-zA-Z0-9_.-]+$`)
+
+// isValidAIR reports whether v looks like an AIR model identifier such as
+// runware:100@1 or civitai:4201@130072
+func isValidAIR(v string) bool {
+	return airPattern.MatchString(v)
+}
+
+// FieldViolation is one invalid field of a request
+type FieldViolation struct {
+	// Path is the JSON path of the field, e.g. controlNet[1].weight
+	Path string
+
+	// Constraint describes the accepted values, e.g. 0-1, or when a field is
+	// required
+	Constraint string
+
+	// Value is the offending value, nil for missing fields
+	Value any
+
+	// Err is ErrFieldRequired, ErrFieldIncorrectVal or the error of an
+	// invalid image input
+	Err error
+}
+
+func (v FieldViolation) Error() string {
+	if v.Constraint != "" {
+		return fmt.Sprintf("%s:[%s][%s]", v.Err.Error(), v.Path, v.Constraint)
+	}
+	return fmt.Sprintf("%s:[%s]", v.Err.Error(), v.Path)
+}
+
+func (v FieldViolation) Unwrap() error {
+	return v.Err
+}
+
+// ValidationError lists every invalid field of a request. errors.Is matches
+// the error of any of its violations, so it still matches ErrFieldRequired
+// and ErrFieldIncorrectVal.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, v := range e.Violations {
+		errs[i] = v
+	}
+	return errs
+}
+
+// validation collects the violations of a request
+type validation struct {
+	violations []FieldViolation
+}
+
+func (v *validation) required(path, constraint string) {
+	v.violations = append(v.violations, FieldViolation{Path: path, Constraint: constraint, Err: ErrFieldRequired})
+}
+
+func (v *validation) incorrect(path, constraint string, value any) {
+	v.violations = append(v.violations, FieldViolation{Path: path, Constraint: constraint, Value: value, Err: ErrFieldIncorrectVal})
+}
+
+func (v *validation) image(path, value string) {
+	if err := validateInputImage(value); err != nil {
+		v.violations = append(v.violations, FieldViolation{Path: path, Value: value, Err: err})
+	}
+}
+
+// err returns a *ValidationError listing the violations, or nil when there
+// are none
+func (v *validation) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v.violations}
+}
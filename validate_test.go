@@ -0,0 +1,47 @@
+package runware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateImageInferenceReqReportsAllViolations(t *testing.T) {
+	req := NewImageInferenceReq{
+		Model:     "runware:101@1",
+		Width:     1000,
+		Height:    1024,
+		MaskImage: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10",
+		ControlNet: []ControlNet{
+			{Model: "civitai:1@1", GuideImage: "4f0c4f2a-6f3b-4c1b-9d3e-2d6c1f3a9b10", Weight: 1},
+			{Model: "civitai:1@1", Weight: 2},
+		},
+	}
+	
+	err := validateImageInferenceReq(*mergeImageInferenceReqWithDefaults(&req))
+	
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, []FieldViolation{
+		{Path: "positivePrompt", Err: ErrFieldRequired},
+		{Path: "width", Constraint: "128-2048, divisible by 16", Value: 1000, Err: ErrFieldIncorrectVal},
+		{Path: "seedImage", Constraint: "when maskImage is provided", Err: ErrFieldRequired},
+		{Path: "controlNet[1].guideImage", Err: ErrFieldRequired},
+		{Path: "controlNet[1].weight", Constraint: "0-1", Value: 2.0, Err: ErrFieldIncorrectVal},
+	}, verr.Violations)
+	
+	assert.True(t, errors.Is(err, ErrFieldRequired))
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+	assert.Contains(t, err.Error(), "field has incorrect value:[controlNet[1].weight][0-1]")
+}
+
+func TestValidateOutputOptionsKeepsMessages(t *testing.T) {
+	err := validateOutputOptions("file", "", 0)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrFieldIncorrectVal))
+	assert.Equal(t, "field has incorrect value:[outputType][URL, base64Data, dataURI]", err.Error())
+	
+	assert.NoError(t, validateOutputOptions("", "", 0))
+}